@@ -0,0 +1,80 @@
+package binarystar
+
+import (
+	"go-astronomy/internal/macros"
+	"math"
+)
+
+// BinaryStarElements holds the Campbell elements of a visual binary. Period is in years and PeriastronEpoch in
+// decimal years, SemiMajorAxis in arcseconds and the angles in decimal degrees. The ascending node is the one
+// lying between 0 and 180 degrees since the orbit alone does not tell the two nodes apart.
+type BinaryStarElements struct {
+	Period               float64
+	PeriastronEpoch      float64
+	Eccentricity         float64
+	SemiMajorAxis        float64
+	Inclination          float64
+	AscendingNode        float64
+	ArgumentOfPeriastron float64
+}
+
+// BinaryStarPosition holds the place of the companion relative to the primary star, the position angle in decimal
+// degrees from the north through east and the separation in arcseconds, with the same offsets towards the north
+// and east in arcseconds.
+type BinaryStarPosition struct {
+	PositionAngle float64
+	Separation    float64
+	North         float64
+	East          float64
+}
+
+func calculatePositionFromEccentricAnomaly(elements BinaryStarElements, E float64) BinaryStarPosition {
+	// Projects the point of the true orbit with eccentric anomaly E (radians) onto the sky
+	e := elements.Eccentricity
+	r := elements.SemiMajorAxis * (1 - (e * math.Cos(E)))
+	nu := 2 * math.Atan(math.Sqrt((1+e)/(1-e))*math.Tan(E/2))
+	u := nu + macros.ConvertDegreesToRadiance(elements.ArgumentOfPeriastron)
+	i := macros.ConvertDegreesToRadiance(elements.Inclination)
+	node := macros.ConvertDegreesToRadiance(elements.AscendingNode)
+
+	theta := node + math.Atan2(math.Sin(u)*math.Cos(i), math.Cos(u))
+	separation := r * math.Sqrt(math.Pow(math.Cos(u), 2)+math.Pow(math.Sin(u)*math.Cos(i), 2))
+	return BinaryStarPosition{
+		PositionAngle: macros.AdjustAngleRange(macros.ConvertRadianceToDegree(theta), 0, 360),
+		Separation:    separation,
+		North:         separation * math.Cos(theta),
+		East:          separation * math.Sin(theta),
+	}
+}
+
+func CalculateBinaryStarPosition(elements BinaryStarElements, epochDecimalYear float64) BinaryStarPosition {
+	// Position angle and separation of the companion at an epoch in decimal years (Meeus chapter 57)
+	meanMotion := 360 / elements.Period
+	M := macros.AdjustAngleRange(math.Mod(meanMotion*(epochDecimalYear-elements.PeriastronEpoch), 360), 0, 360)
+	E := macros.CalculateEccentricAnomaly(macros.ConvertDegreesToRadiance(M), elements.Eccentricity)
+	return calculatePositionFromEccentricAnomaly(elements, E)
+}
+
+func CalculateApparentOrbit(elements BinaryStarElements, numPoints int) []BinaryStarPosition {
+	// Points of the apparent orbit evenly spaced in eccentric anomaly, starting at periastron, which draw the
+	// ellipse the companion traces on the sky
+	points := make([]BinaryStarPosition, 0, numPoints)
+	for k := 0; k < numPoints; k++ {
+		E := 2 * math.Pi * float64(k) / float64(numPoints)
+		points = append(points, calculatePositionFromEccentricAnomaly(elements, E))
+	}
+	return points
+}
+
+func CalculateApparentOrbitEccentricity(elements BinaryStarElements) float64 {
+	// Eccentricity of the ellipse of the apparent orbit, which differs from that of the true orbit
+	e := elements.Eccentricity
+	omega := macros.ConvertDegreesToRadiance(elements.ArgumentOfPeriastron)
+	cosI := math.Cos(macros.ConvertDegreesToRadiance(elements.Inclination))
+
+	A := (1 - (math.Pow(e, 2) * math.Pow(math.Cos(omega), 2))) * math.Pow(cosI, 2)
+	B := math.Pow(e, 2) * math.Sin(omega) * math.Cos(omega) * cosI
+	C := 1 - (math.Pow(e, 2) * math.Pow(math.Sin(omega), 2))
+	D := math.Sqrt(math.Pow(A-C, 2) + (4 * math.Pow(B, 2)))
+	return math.Sqrt(2 * D / (A + C + D))
+}
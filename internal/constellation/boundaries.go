@@ -0,0 +1,314 @@
+package constellation
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"go-astronomy/internal/coords"
+	"go-astronomy/internal/macros"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Julian ephemeris date of the Besselian epoch B1875.0, the equinox of the IAU boundaries
+const B1875 = 2405889.25855
+
+// Largest step in right ascension in decimal degrees between points exported along a boundary of constant
+// declination, which is no longer a line of constant declination once precessed
+const boundaryStep = 1.0
+
+var ErrPositionNotCovered = errors.New("position not covered by the boundary table")
+
+// BoundaryRow holds one line of the table of Roman (1987): the constellation reaches down to DecLowerDecimalDeg
+// between the two right ascensions, for positions not taken by an earlier line. Coordinates are referred to the
+// equinox of B1875.0.
+type BoundaryRow struct {
+	RALowerDecimalHrs  float64
+	RAUpperDecimalHrs  float64
+	DecLowerDecimalDeg float64
+	Abbreviation       string
+}
+
+// BoundaryTable holds the lines of the table in their original order, declination decreasing, which the search
+// depends on
+type BoundaryTable []BoundaryRow
+
+// BoundaryPoint holds a vertex of the outline of a constellation
+type BoundaryPoint struct {
+	RADecimalHrs  float64
+	DecDecimalDeg float64
+}
+
+func ParseBoundaryLine(line string) (BoundaryRow, error) {
+	// Line of the file data.dat of Roman's table (VizieR VI/42) such as " 0.0000 24.0000  88.0000 UMi"
+	fields := strings.Fields(line)
+	if len(fields) < 4 {
+		return BoundaryRow{}, fmt.Errorf("expected 4 fields in a boundary line, got %d", len(fields))
+	}
+	values := [3]float64{}
+	for i := range values {
+		value, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return BoundaryRow{}, fmt.Errorf("invalid number %q in boundary line", fields[i])
+		}
+		values[i] = value
+	}
+	info, err := FindConstellationInfo(fields[3])
+	if err != nil {
+		return BoundaryRow{}, fmt.Errorf("%w %q", err, fields[3])
+	}
+	return BoundaryRow{RALowerDecimalHrs: values[0], RAUpperDecimalHrs: values[1], DecLowerDecimalDeg: values[2], Abbreviation: info.Abbreviation}, nil
+}
+
+func ParseBoundaryTable(reader io.Reader, name string) (BoundaryTable, error) {
+	// Table of constellation boundaries in the format of Roman's data.dat, whose last line covers what is left
+	// around the south pole. The name is only used in error messages.
+	table := BoundaryTable{}
+	scanner := bufio.NewScanner(reader)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		row, err := ParseBoundaryLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", name, lineNumber, err)
+		}
+		if len(table) > 0 && row.DecLowerDecimalDeg > table[len(table)-1].DecLowerDecimalDeg {
+			return nil, fmt.Errorf("%s:%d: declinations must not increase", name, lineNumber)
+		}
+		table = append(table, row)
+	}
+	return table, scanner.Err()
+}
+
+func ReadBoundaryTable(path string) (BoundaryTable, error) {
+	// Table of constellation boundaries from a copy of Roman's data.dat
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ParseBoundaryTable(file, path)
+}
+
+func (table BoundaryTable) findRow(raDecimalHrs, decDecimalDeg float64) (BoundaryRow, error) {
+	// First line of the table containing a position referred to B1875.0
+	for _, row := range table {
+		if decDecimalDeg >= row.DecLowerDecimalDeg && raDecimalHrs >= row.RALowerDecimalHrs && raDecimalHrs < row.RAUpperDecimalHrs {
+			return row, nil
+		}
+	}
+	return BoundaryRow{}, ErrPositionNotCovered
+}
+
+func (table BoundaryTable) FindConstellation(raDecimalHrs, decDecimalDeg, epochJulianEphemerisDate float64) (ConstellationInfo, error) {
+	// Constellation containing a position referred to the mean equator and equinox of a date, for instance
+	// 2451545.0 for J2000.0
+	b1875 := coords.EquatorialCoordinates{RADecimalHrs: raDecimalHrs, DecDecimalDeg: decDecimalDeg}.Precess(epochJulianEphemerisDate, B1875)
+	row, err := table.findRow(math.Mod(b1875.RADecimalHrs, 24), b1875.DecDecimalDeg)
+	if err != nil {
+		return ConstellationInfo{}, err
+	}
+	return FindConstellationInfo(row.Abbreviation)
+}
+
+// boundaryGrid holds the cells between all the right ascensions and declinations of a table, each lying in a
+// single constellation
+type boundaryGrid struct {
+	ras    []float64
+	decs   []float64
+	owners [][]string
+}
+
+// gridVertex holds the column and row indices of a corner of the cells
+type gridVertex struct {
+	i int
+	j int
+}
+
+func (table BoundaryTable) calculateGrid() (boundaryGrid, error) {
+	grid := boundaryGrid{}
+	raSet, decSet := map[float64]bool{0: true, 24: true}, map[float64]bool{-90: true, 90: true}
+	for _, row := range table {
+		raSet[row.RALowerDecimalHrs], raSet[row.RAUpperDecimalHrs] = true, true
+		decSet[math.Max(row.DecLowerDecimalDeg, -90)] = true
+	}
+	for ra := range raSet {
+		grid.ras = append(grid.ras, ra)
+	}
+	for dec := range decSet {
+		grid.decs = append(grid.decs, dec)
+	}
+	sort.Float64s(grid.ras)
+	sort.Float64s(grid.decs)
+
+	grid.owners = make([][]string, len(grid.ras)-1)
+	for i := range grid.owners {
+		grid.owners[i] = make([]string, len(grid.decs)-1)
+		for j := range grid.owners[i] {
+			row, err := table.findRow((grid.ras[i]+grid.ras[i+1])/2, (grid.decs[j]+grid.decs[j+1])/2)
+			if err != nil {
+				return grid, err
+			}
+			grid.owners[i][j] = row.Abbreviation
+		}
+	}
+	return grid, nil
+}
+
+func (table BoundaryTable) CalculateConstellationArea(abbreviation string) (float64, error) {
+	// Area in square degrees the table gives a constellation, a check of the table against the IAU areas
+	info, err := FindConstellationInfo(abbreviation)
+	if err != nil {
+		return 0, err
+	}
+	grid, err := table.calculateGrid()
+	if err != nil {
+		return 0, err
+	}
+	area := 0.0
+	for i, column := range grid.owners {
+		for j, owner := range column {
+			if owner == info.Abbreviation {
+				width := macros.ConvertDegreesToRadiance((grid.ras[i+1] - grid.ras[i]) * 15)
+				area += width * (math.Sin(macros.ConvertDegreesToRadiance(grid.decs[j+1])) - math.Sin(macros.ConvertDegreesToRadiance(grid.decs[j])))
+			}
+		}
+	}
+	return area * math.Pow(180/math.Pi, 2), nil
+}
+
+func (table BoundaryTable) CalculateBoundaryPolygons(abbreviation string, epochJulianEphemerisDate float64) ([][]BoundaryPoint, error) {
+	// Outlines of a constellation precessed to the mean equator and equinox of a date, one closed polygon for each
+	// separate part, the first vertex not repeated at the end. Each outline keeps the constellation on its left
+	// when drawn on a chart with right ascension increasing to the right. An outline around a pole never reaches it.
+	info, err := FindConstellationInfo(abbreviation)
+	if err != nil {
+		return nil, err
+	}
+	grid, err := table.calculateGrid()
+	if err != nil {
+		return nil, err
+	}
+
+	// Directed sides of the cells of the constellation that face another constellation, column 0 and the last
+	// column being the same meridian
+	columns := len(grid.ras) - 1
+	wrap := func(i int) int { return ((i % columns) + columns) % columns }
+	owner := func(i, j int) string {
+		if j < 0 || j >= len(grid.decs)-1 {
+			return ""
+		}
+		return grid.owners[wrap(i)][j]
+	}
+	next := map[gridVertex][]gridVertex{}
+	edgeCount := 0
+	for i := 0; i < columns; i++ {
+		for j := range grid.owners[i] {
+			if grid.owners[i][j] != info.Abbreviation {
+				continue
+			}
+			sides := [][2]gridVertex{}
+			if owner(i, j-1) != info.Abbreviation && grid.decs[j] > -90 {
+				sides = append(sides, [2]gridVertex{{i, j}, {i + 1, j}})
+			}
+			if owner(i+1, j) != info.Abbreviation {
+				sides = append(sides, [2]gridVertex{{i + 1, j}, {i + 1, j + 1}})
+			}
+			if owner(i, j+1) != info.Abbreviation && grid.decs[j+1] < 90 {
+				sides = append(sides, [2]gridVertex{{i + 1, j + 1}, {i, j + 1}})
+			}
+			if owner(i-1, j) != info.Abbreviation {
+				sides = append(sides, [2]gridVertex{{i, j + 1}, {i, j}})
+			}
+			for _, side := range sides {
+				from, to := gridVertex{wrap(side[0].i), side[0].j}, gridVertex{wrap(side[1].i), side[1].j}
+				next[from] = append(next[from], to)
+				edgeCount++
+			}
+		}
+	}
+
+	// Chain the sides into closed outlines, merging sides along the same line
+	starts := make([]gridVertex, 0, len(next))
+	for start := range next {
+		starts = append(starts, start)
+	}
+	sort.Slice(starts, func(a, b int) bool {
+		if starts[a].j != starts[b].j {
+			return starts[a].j > starts[b].j
+		}
+		return starts[a].i < starts[b].i
+	})
+	polygons := [][]BoundaryPoint{}
+	for _, start := range starts {
+		for len(next[start]) > 0 {
+			corners := []gridVertex{start}
+			current := start
+			for {
+				to := next[current][0]
+				next[current] = next[current][1:]
+				edgeCount--
+				if to == start {
+					break
+				}
+				corners = append(corners, to)
+				current = to
+			}
+			polygons = append(polygons, grid.convertCornersToPolygon(corners, columns, epochJulianEphemerisDate))
+		}
+	}
+	if edgeCount != 0 {
+		return nil, fmt.Errorf("boundary of %s does not close", info.Abbreviation)
+	}
+	return polygons, nil
+}
+
+func (grid boundaryGrid) convertCornersToPolygon(corners []gridVertex, columns int, epochJulianEphemerisDate float64) []BoundaryPoint {
+	// Keeps only the corners where the outline turns, adds points along the parallels and precesses them
+	isParallel := func(k int) bool {
+		return corners[k].j == corners[(k+1)%len(corners)].j
+	}
+	turns := []int{}
+	for k := range corners {
+		if isParallel((k+len(corners)-1)%len(corners)) != isParallel(k) {
+			turns = append(turns, k)
+		}
+	}
+	if len(turns) == 0 {
+		// A circle of declination around a pole
+		turns = []int{0}
+	}
+
+	polygon := []BoundaryPoint{}
+	for n, k := range turns {
+		corner, following := corners[k], corners[turns[(n+1)%len(turns)]]
+		dec := grid.decs[corner.j]
+		ra := grid.ras[corner.i]
+		points := []BoundaryPoint{{RADecimalHrs: ra, DecDecimalDeg: dec}}
+		if isParallel(k) {
+			// Eastwards or westwards along the parallel to the next turn, all the way round for a polar circle
+			span := math.Mod(grid.ras[following.i]-ra+24, 24)
+			if corners[(k+1)%len(corners)].i != (corner.i+1)%columns {
+				span = -math.Mod(ra-grid.ras[following.i]+24, 24)
+			}
+			if len(turns) == 1 {
+				span = math.Copysign(24, span)
+			}
+			steps := int(math.Ceil(math.Abs(span) * 15 / boundaryStep))
+			for step := 1; step < steps; step++ {
+				points = append(points, BoundaryPoint{RADecimalHrs: math.Mod(ra+(span*float64(step)/float64(steps))+24, 24), DecDecimalDeg: dec})
+			}
+		}
+		for _, point := range points {
+			precessed := coords.EquatorialCoordinates{RADecimalHrs: point.RADecimalHrs, DecDecimalDeg: point.DecDecimalDeg}.Precess(B1875, epochJulianEphemerisDate)
+			polygon = append(polygon, BoundaryPoint{RADecimalHrs: math.Mod(precessed.RADecimalHrs, 24), DecDecimalDeg: precessed.DecDecimalDeg})
+		}
+	}
+	return polygon
+}
@@ -0,0 +1,120 @@
+package constellation
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrUnknownConstellation = errors.New("unknown constellation")
+
+// ConstellationInfo holds the IAU abbreviation of a constellation, its name, the genitive used in star names such
+// as alpha Canis Majoris, and its area in square degrees (Delporte 1930)
+type ConstellationInfo struct {
+	Abbreviation string
+	Name         string
+	Genitive     string
+	Area         float64
+}
+
+// The 88 constellations in the alphabetical order of their abbreviations. Serpens is one constellation although its
+// two parts, Caput and Cauda, lie on either side of Ophiuchus.
+var Constellations = []ConstellationInfo{
+	{"And", "Andromeda", "Andromedae", 722.278},
+	{"Ant", "Antlia", "Antliae", 238.901},
+	{"Aps", "Apus", "Apodis", 206.327},
+	{"Aql", "Aquila", "Aquilae", 652.473},
+	{"Aqr", "Aquarius", "Aquarii", 979.854},
+	{"Ara", "Ara", "Arae", 237.057},
+	{"Ari", "Aries", "Arietis", 441.395},
+	{"Aur", "Auriga", "Aurigae", 657.438},
+	{"Boo", "Boötes", "Boötis", 906.831},
+	{"CMa", "Canis Major", "Canis Majoris", 380.118},
+	{"CMi", "Canis Minor", "Canis Minoris", 183.367},
+	{"CVn", "Canes Venatici", "Canum Venaticorum", 465.194},
+	{"Cae", "Caelum", "Caeli", 124.865},
+	{"Cam", "Camelopardalis", "Camelopardalis", 756.828},
+	{"Cap", "Capricornus", "Capricorni", 413.947},
+	{"Car", "Carina", "Carinae", 494.184},
+	{"Cas", "Cassiopeia", "Cassiopeiae", 598.407},
+	{"Cen", "Centaurus", "Centauri", 1060.422},
+	{"Cep", "Cepheus", "Cephei", 587.787},
+	{"Cet", "Cetus", "Ceti", 1231.411},
+	{"Cha", "Chamaeleon", "Chamaeleontis", 131.592},
+	{"Cir", "Circinus", "Circini", 93.353},
+	{"Cnc", "Cancer", "Cancri", 505.872},
+	{"Col", "Columba", "Columbae", 270.184},
+	{"Com", "Coma Berenices", "Comae Berenices", 386.475},
+	{"CrA", "Corona Australis", "Coronae Australis", 127.696},
+	{"CrB", "Corona Borealis", "Coronae Borealis", 178.710},
+	{"Crt", "Crater", "Crateris", 282.398},
+	{"Cru", "Crux", "Crucis", 68.447},
+	{"Crv", "Corvus", "Corvi", 183.801},
+	{"Cyg", "Cygnus", "Cygni", 803.983},
+	{"Del", "Delphinus", "Delphini", 188.549},
+	{"Dor", "Dorado", "Doradus", 179.173},
+	{"Dra", "Draco", "Draconis", 1082.952},
+	{"Equ", "Equuleus", "Equulei", 71.641},
+	{"Eri", "Eridanus", "Eridani", 1137.919},
+	{"For", "Fornax", "Fornacis", 397.502},
+	{"Gem", "Gemini", "Geminorum", 513.761},
+	{"Gru", "Grus", "Gruis", 365.513},
+	{"Her", "Hercules", "Herculis", 1225.148},
+	{"Hor", "Horologium", "Horologii", 248.885},
+	{"Hya", "Hydra", "Hydrae", 1302.844},
+	{"Hyi", "Hydrus", "Hydri", 243.035},
+	{"Ind", "Indus", "Indi", 294.006},
+	{"LMi", "Leo Minor", "Leonis Minoris", 231.956},
+	{"Lac", "Lacerta", "Lacertae", 200.688},
+	{"Leo", "Leo", "Leonis", 946.964},
+	{"Lep", "Lepus", "Leporis", 290.291},
+	{"Lib", "Libra", "Librae", 538.052},
+	{"Lup", "Lupus", "Lupi", 333.683},
+	{"Lyn", "Lynx", "Lyncis", 545.386},
+	{"Lyr", "Lyra", "Lyrae", 286.476},
+	{"Men", "Mensa", "Mensae", 153.484},
+	{"Mic", "Microscopium", "Microscopii", 209.513},
+	{"Mon", "Monoceros", "Monocerotis", 481.569},
+	{"Mus", "Musca", "Muscae", 138.355},
+	{"Nor", "Norma", "Normae", 165.290},
+	{"Oct", "Octans", "Octantis", 291.045},
+	{"Oph", "Ophiuchus", "Ophiuchi", 948.340},
+	{"Ori", "Orion", "Orionis", 594.120},
+	{"Pav", "Pavo", "Pavonis", 377.666},
+	{"Peg", "Pegasus", "Pegasi", 1120.794},
+	{"Per", "Perseus", "Persei", 614.997},
+	{"Phe", "Phoenix", "Phoenicis", 469.319},
+	{"Pic", "Pictor", "Pictoris", 246.739},
+	{"PsA", "Piscis Austrinus", "Piscis Austrini", 245.375},
+	{"Psc", "Pisces", "Piscium", 889.417},
+	{"Pup", "Puppis", "Puppis", 673.434},
+	{"Pyx", "Pyxis", "Pyxidis", 220.833},
+	{"Ret", "Reticulum", "Reticuli", 113.936},
+	{"Scl", "Sculptor", "Sculptoris", 474.764},
+	{"Sco", "Scorpius", "Scorpii", 496.783},
+	{"Sct", "Scutum", "Scuti", 109.114},
+	{"Ser", "Serpens", "Serpentis", 636.928},
+	{"Sex", "Sextans", "Sextantis", 313.515},
+	{"Sge", "Sagitta", "Sagittae", 79.932},
+	{"Sgr", "Sagittarius", "Sagittarii", 867.432},
+	{"Tau", "Taurus", "Tauri", 797.249},
+	{"Tel", "Telescopium", "Telescopii", 251.512},
+	{"TrA", "Triangulum Australe", "Trianguli Australis", 109.978},
+	{"Tri", "Triangulum", "Trianguli", 131.847},
+	{"Tuc", "Tucana", "Tucanae", 294.557},
+	{"UMa", "Ursa Major", "Ursae Majoris", 1279.660},
+	{"UMi", "Ursa Minor", "Ursae Minoris", 255.864},
+	{"Vel", "Vela", "Velorum", 499.649},
+	{"Vir", "Virgo", "Virginis", 1294.428},
+	{"Vol", "Volans", "Volantis", 141.354},
+	{"Vul", "Vulpecula", "Vulpeculae", 268.165},
+}
+
+func FindConstellationInfo(abbreviation string) (ConstellationInfo, error) {
+	// Constellation of an IAU abbreviation, in any letter case since tables such as Roman's use upper case
+	for _, info := range Constellations {
+		if strings.EqualFold(info.Abbreviation, strings.TrimSpace(abbreviation)) {
+			return info, nil
+		}
+	}
+	return ConstellationInfo{}, ErrUnknownConstellation
+}
@@ -0,0 +1,144 @@
+package coords
+
+import (
+	"errors"
+	"go-astronomy/internal/macros"
+	"math"
+)
+
+var ErrBelowHorizon = errors.New("body is below the horizon")
+var ErrUnknownAirmassModel = errors.New("unknown airmass model")
+var ErrAirmassOutOfRange = errors.New("altitude is below the range of the airmass model")
+
+// Largest zenith distance in decimal degrees at which the Hardie polynomial is used, beyond it the polynomial turns
+// over and goes negative
+const hardieZenithDistanceLimit = 85.0
+
+// AirmassModel selects the formula giving the path length through the atmosphere relative to that at the zenith
+type AirmassModel int
+
+const (
+	// Secant of the zenith distance for a plane-parallel atmosphere, good above about 30 degrees
+	AirmassSecant AirmassModel = iota
+	// Hardie (1962) polynomial in the secant, good to a zenith distance of 85 degrees and refused beyond
+	AirmassHardie
+	// Kasten & Young (1989), good down to the horizon
+	AirmassKastenYoung
+	// Pickering (2002), good down to the horizon
+	AirmassPickering
+)
+
+// Aerosol optical depth at 550 nm of a clear sky at sea level, and the scale height in metres over which it falls off
+const ClearAerosolOpticalDepth = 0.1
+const aerosolScaleHeight = 1500.0
+
+// Mean total ozone column in Dobson units
+const StandardOzoneColumn = 300.0
+
+// Magnitudes of extinction per unit of optical depth, 2.5·log10(e)
+const magnitudesPerOpticalDepth = 1.0857362047581294
+
+// Ozone absorption coefficients per atm-cm of the Huggins and Chappuis bands (Leckner 1978), wavelengths in nm
+var ozoneAbsorptionWavelengths = []float64{300, 310, 320, 330, 340, 350, 360, 440, 450, 470, 490, 510, 530, 550, 570, 590, 600, 610, 630, 650, 670, 700, 730, 760, 780}
+var ozoneAbsorptionCoefficients = []float64{10.0, 2.7, 0.8, 0.16, 0.04, 0.007, 0, 0, 0.003, 0.009, 0.021, 0.04, 0.063, 0.085, 0.12, 0.115, 0.125, 0.12, 0.09, 0.067, 0.048, 0.023, 0.011, 0.007, 0}
+
+// Atmosphere holds the conditions above an observer, temperature in degrees Celsius, pressure in millibars,
+// aerosol optical depth at 550 nm and total ozone column in Dobson units
+type Atmosphere struct {
+	Temperature         float64
+	Pressure            float64
+	AerosolOpticalDepth float64
+	OzoneColumn         float64
+}
+
+// ExtinctionCoefficients holds the extinction at the zenith in magnitudes per airmass from scattering by molecules,
+// scattering by aerosols and absorption by ozone
+type ExtinctionCoefficients struct {
+	Rayleigh float64
+	Aerosol  float64
+	Ozone    float64
+}
+
+func (coefficients ExtinctionCoefficients) CalculateTotal() float64 {
+	return coefficients.Rayleigh + coefficients.Aerosol + coefficients.Ozone
+}
+
+func CalculateAirmass(apparentAltitude float64, model AirmassModel) (float64, error) {
+	// Relative airmass of the line of sight at an apparent altitude in decimal degrees
+	if apparentAltitude < 0 || (apparentAltitude == 0 && (model == AirmassSecant || model == AirmassHardie)) {
+		return 0, ErrBelowHorizon
+	}
+	zenithDistance := 90 - apparentAltitude
+	secant := 1 / math.Cos(macros.ConvertDegreesToRadiance(zenithDistance))
+
+	switch model {
+	case AirmassSecant:
+		return secant, nil
+	case AirmassHardie:
+		if zenithDistance > hardieZenithDistanceLimit {
+			return 0, ErrAirmassOutOfRange
+		}
+		return secant - (0.0018167 * (secant - 1)) - (0.002875 * math.Pow(secant-1, 2)) - (0.0008083 * math.Pow(secant-1, 3)), nil
+	case AirmassKastenYoung:
+		return 1 / (math.Cos(macros.ConvertDegreesToRadiance(zenithDistance)) + (0.50572 * math.Pow(96.07995-zenithDistance, -1.6364))), nil
+	case AirmassPickering:
+		return 1 / math.Sin(macros.ConvertDegreesToRadiance(apparentAltitude+(244/(165+(47*math.Pow(apparentAltitude, 1.1)))))), nil
+	}
+	return 0, ErrUnknownAirmassModel
+}
+
+func CalculateStandardAtmosphere(heightFromSeaLevel float64) Atmosphere {
+	// Temperature and pressure of the International Standard Atmosphere at a height in metres, with a clear sky and
+	// the mean ozone column
+	return Atmosphere{
+		Temperature:         15 - (0.0065 * heightFromSeaLevel),
+		Pressure:            1013.25 * math.Pow(1-(2.25577e-5*heightFromSeaLevel), 5.25588),
+		AerosolOpticalDepth: ClearAerosolOpticalDepth * math.Exp(-heightFromSeaLevel/aerosolScaleHeight),
+		OzoneColumn:         StandardOzoneColumn,
+	}
+}
+
+func calculateOzoneAbsorption(wavelengthNm float64) float64 {
+	// Absorption coefficient per atm-cm interpolated in the table, held at its ends
+	last := len(ozoneAbsorptionWavelengths) - 1
+	if wavelengthNm <= ozoneAbsorptionWavelengths[0] {
+		return ozoneAbsorptionCoefficients[0]
+	}
+	if wavelengthNm >= ozoneAbsorptionWavelengths[last] {
+		return ozoneAbsorptionCoefficients[last]
+	}
+	i := 1
+	for ozoneAbsorptionWavelengths[i] < wavelengthNm {
+		i++
+	}
+	fraction := (wavelengthNm - ozoneAbsorptionWavelengths[i-1]) / (ozoneAbsorptionWavelengths[i] - ozoneAbsorptionWavelengths[i-1])
+	return ozoneAbsorptionCoefficients[i-1] + (fraction * (ozoneAbsorptionCoefficients[i] - ozoneAbsorptionCoefficients[i-1]))
+}
+
+func CalculateExtinctionCoefficients(wavelengthNm float64, atmosphere Atmosphere) ExtinctionCoefficients {
+	// Zenith extinction at a wavelength in nm. The Rayleigh optical depth (Hansen & Travis 1974) scales with the
+	// pressure, the aerosol one follows the Angstrom law with an exponent of 1.3 and the ozone one the absorption
+	// of the ozone column.
+	wavelengthMicron := wavelengthNm / 1000
+	rayleigh := 0.008569 * math.Pow(wavelengthMicron, -4) * (1 + (0.0113 * math.Pow(wavelengthMicron, -2)) + (0.00013 * math.Pow(wavelengthMicron, -4)))
+	rayleigh *= atmosphere.Pressure / 1013.25
+	aerosol := atmosphere.AerosolOpticalDepth * math.Pow(wavelengthMicron/0.55, -1.3)
+	ozone := calculateOzoneAbsorption(wavelengthNm) * atmosphere.OzoneColumn / 1000
+
+	return ExtinctionCoefficients{
+		Rayleigh: magnitudesPerOpticalDepth * rayleigh,
+		Aerosol:  magnitudesPerOpticalDepth * aerosol,
+		Ozone:    magnitudesPerOpticalDepth * ozone,
+	}
+}
+
+func CalculateExtinction(altitude, wavelengthNm float64, atmosphere Atmosphere, model AirmassModel) (float64, error) {
+	// Extinction in magnitudes at a wavelength in nm of a body at a geometric altitude in decimal degrees, the
+	// altitude first raised by the refraction of the atmosphere
+	apparentAltitude := altitude + CalculateRefractionDecimalDeg(altitude, atmosphere.Temperature, atmosphere.Pressure)
+	airmass, err := CalculateAirmass(apparentAltitude, model)
+	if err != nil {
+		return 0, err
+	}
+	return CalculateExtinctionCoefficients(wavelengthNm, atmosphere).CalculateTotal() * airmass, nil
+}
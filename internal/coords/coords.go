@@ -42,37 +42,11 @@ func ConverHourAngleToRightAscension(localDay float64, localMonth int, localYear
 }
 
 func ConvertEquatorialToHorizonCoordinates(raHours, raMinutes int, raSeconds float64, decDegrees, decMinutes int, decSeconds, latitude float64) (altitudeDeg, altitudeMin int, altitudeSec float64, azimuthDeg, azimuthMin int, azimuthSec float64) {
-	// Convert Right Ascension (RA) to decimal hours
-	decimalRAHours := datetime.ConvertHrsMinSecToDecimalHrs(raHours, raMinutes, raSeconds, false, false)
-	// Convert decimal Right Ascension hours to degrees
-	decimalRADegrees := ConvertDecimalHrsToDecimalDegress(decimalRAHours)
-
-	// Convert Declination to decimal degrees
+	// The right ascension arguments hold the hour angle, ConvertEquatorialToHorizon takes a right ascension
+	hourAngleDeg := ConvertDecimalHrsToDecimalDegress(datetime.ConvertHrsMinSecToDecimalHrs(raHours, raMinutes, raSeconds, false, false))
 	decimalDeclination := macros.ConvertDegMinSecToDecimalDeg(decDegrees, decMinutes, decSeconds)
 
-	// Convert observer's latitude to radians
-	latitudeRad := latitude * math.Pi / 180.0
-
-	// Calculate the altitude (altitude angle) in radians
-	sineAlt := math.Asin(
-		math.Sin(decimalDeclination*(math.Pi/180.0))*math.Sin(latitudeRad) +
-			math.Cos(decimalDeclination*(math.Pi/180.0))*math.Cos(latitudeRad)*math.Cos(decimalRADegrees*(math.Pi/180.0)),
-	)
-
-	// Calculate the azimuth in radians
-	cosAz := (math.Sin(decimalDeclination*(math.Pi/180.0)) - math.Sin(latitudeRad)*math.Sin(sineAlt)) /
-		(math.Cos(latitudeRad) * math.Cos(sineAlt))
-	sineAz := math.Sin(decimalRADegrees * math.Pi / 180.0)
-	azimuthRad := math.Acos(cosAz)
-
-	// Adjust azimuth for the correct quadrant
-	if sineAz > 0 {
-		azimuthRad = 2*math.Pi - azimuthRad
-	}
-
-	// Convert radians to degrees
-	altitudeDegDec := sineAlt * (180.0 / math.Pi)
-	azimuthDegDec := azimuthRad * (180.0 / math.Pi)
+	altitudeDegDec, azimuthDegDec := ConvertHourAngleDecimalDegToHorizon(hourAngleDeg, decimalDeclination, latitude)
 
 	// Convert decimal degrees to degrees, minutes, seconds
 	altitudeDeg, altitudeMin, altitudeSec = macros.ConvertDecimalDegToDegMinSec(altitudeDegDec)
@@ -82,18 +56,12 @@ func ConvertEquatorialToHorizonCoordinates(raHours, raMinutes int, raSeconds flo
 }
 
 func ConvertHorizonCoordinatesToEquatorial(GSTHrs, GSTMin int, GSec float64, altitudeDeg, altitudeMin int, altitudeSec float64, azimuthDeg, azimuthMin int, azimuthSec, latitude float64) (haHrs, haMin int, haSec float64, decDeg, decMin int, decSec float64) {
+	// The sidereal time arguments are unused and the hour angle is returned, ConvertHorizonToEquatorial gives the
+	// right ascension
 	altitudeDecimalDeg := macros.ConvertDegMinSecToDecimalDeg(altitudeDeg, altitudeMin, altitudeSec)
 	azimuthDecimalDeg := macros.ConvertDegMinSecToDecimalDeg(azimuthDeg, azimuthMin, azimuthSec)
 
-	declination := macros.ConvertRadianceToDegree(math.Asin((math.Sin(macros.ConvertDegreesToRadiance(altitudeDecimalDeg)) * math.Sin(macros.ConvertDegreesToRadiance(latitude))) + (math.Cos(macros.ConvertDegreesToRadiance(altitudeDecimalDeg)) * math.Cos(macros.ConvertDegreesToRadiance(latitude)) * math.Cos(macros.ConvertDegreesToRadiance(azimuthDecimalDeg)))))
-	cosInvHourAngle := macros.ConvertRadianceToDegree(math.Acos((math.Sin(macros.ConvertDegreesToRadiance(altitudeDecimalDeg)) - (math.Sin(macros.ConvertDegreesToRadiance(latitude)) * math.Sin(macros.ConvertDegreesToRadiance(declination)))) / (math.Cos(macros.ConvertDegreesToRadiance(latitude)) * math.Cos(macros.ConvertDegreesToRadiance(declination)))))
-
-	hourAngleInDecimalDeg := 0.0
-	if macros.ConvertRadianceToDegree(math.Sin(macros.ConvertDegreesToRadiance(azimuthDecimalDeg))) < 0 {
-		hourAngleInDecimalDeg = cosInvHourAngle
-	} else {
-		hourAngleInDecimalDeg = 360 - cosInvHourAngle
-	}
+	hourAngleInDecimalDeg, declination := CalculateHourAngleToHorizonMatrix(latitude).Transpose().ApplyDecimalDeg(azimuthDecimalDeg, altitudeDecimalDeg)
 	hourAngleInDecimalHrs := macros.ConvertDecimalDegressToDecimalHrs(hourAngleInDecimalDeg)
 
 	haHrs, haMin, haSec = datetime.ConvertDecimalHrsToHrsMinSec(hourAngleInDecimalHrs)
@@ -106,14 +74,8 @@ func ConvertEquatorialCoordinatesToEcliptic(Gday float64, GMonth, GYear, raHrs,
 	raDecimalDeg := ConvertDecimalHrsToDecimalDegress(datetime.ConvertHrsMinSecToDecimalHrs(raHrs, raMin, raSec, false, false))
 	decDecimalDeg := macros.ConvertDegMinSecToDecimalDeg(decDeg, decMin, decSec)
 	_, _, _, meanObliquity := macros.CalculateEclipticMeanObliquity(Gday, GMonth, GYear)
-	// fmt.Printf("meanObliquity : %f\n", meanObliquity)
 
-	latDecimal := macros.ConvertRadianceToDegree(math.Asin((math.Sin(macros.ConvertDegreesToRadiance(decDecimalDeg)) * math.Cos(macros.ConvertDegreesToRadiance(meanObliquity))) - (math.Cos(macros.ConvertDegreesToRadiance(decDecimalDeg)) * math.Sin(macros.ConvertDegreesToRadiance(meanObliquity)) * math.Sin(macros.ConvertDegreesToRadiance(raDecimalDeg)))))
-	// fmt.Printf("sineB : %f\n", macros.ConvertRadianceToDegree(sineB))
-
-	y := (math.Sin(macros.ConvertDegreesToRadiance(raDecimalDeg)) * math.Cos(macros.ConvertDegreesToRadiance(meanObliquity))) + (math.Tan(macros.ConvertDegreesToRadiance(decDecimalDeg)) * math.Sin(macros.ConvertDegreesToRadiance(meanObliquity)))
-	x := math.Cos(macros.ConvertDegreesToRadiance(raDecimalDeg))
-	longDecimal := macros.ConvertRadianceToDegree(math.Atan2(y, x))
+	longDecimal, latDecimal := CalculateEquatorialToEclipticMatrix(meanObliquity).ApplyDecimalDeg(raDecimalDeg, decDecimalDeg)
 
 	latDeg, latMin, latSec := macros.ConvertDecimalDegToDegMinSec(latDecimal)
 	longDeg, longMin, longSec := macros.ConvertDecimalDegToDegMinSec(longDecimal)
@@ -125,11 +87,7 @@ func ConvertEquatorialCoordinateToGalactic(raHrs, raMin int, raSec float64, decD
 	raDecimalDeg := ConvertDecimalHrsToDecimalDegress(datetime.ConvertHrsMinSecToDecimalHrs(raHrs, raMin, raSec, false, false))
 	decDecimalDeg := macros.ConvertDegMinSecToDecimalDeg(decDeg, decMin, decSec)
 
-	b := macros.ConvertRadianceToDegree(math.Asin((math.Cos(macros.ConvertDegreesToRadiance(decDecimalDeg)) * math.Cos(macros.ConvertDegreesToRadiance(27.4)) * math.Cos(macros.ConvertDegreesToRadiance(raDecimalDeg)-macros.ConvertDegreesToRadiance(192.25))) + (math.Sin(macros.ConvertDegreesToRadiance(decDecimalDeg)) * math.Sin(macros.ConvertDegreesToRadiance(27.4)))))
-
-	y := math.Sin(macros.ConvertDegreesToRadiance(decDecimalDeg)) - math.Sin(macros.ConvertDegreesToRadiance(b))*math.Sin(macros.ConvertDegreesToRadiance(27.4))
-	x := math.Cos(macros.ConvertDegreesToRadiance(decDecimalDeg)) * math.Sin(macros.ConvertDegreesToRadiance(raDecimalDeg)-macros.ConvertDegreesToRadiance(192.25)) * math.Cos(macros.ConvertDegreesToRadiance(27.4))
-	l := macros.AdjustAngleRange(macros.ConvertRadianceToDegree(math.Atan2(y, x))+33.0, 0, 360)
+	l, b := equatorialToGalacticMatrix.ApplyDecimalDeg(raDecimalDeg, decDecimalDeg)
 
 	lDeg, lMin, lSec = macros.ConvertDecimalDegToDegMinSec(l)
 	bDeg, bMin, bSec = macros.ConvertDecimalDegToDegMinSec(b)
@@ -140,11 +98,8 @@ func ConvertEquatorialCoordinateToGalactic(raHrs, raMin int, raSec float64, decD
 func ConvertGalacticCoordinateToEquatorial(lHrs, lMin int, lSec float64, bDeg, bMin int, bSec float64) (raHrs, raMin int, raSec float64, decDeg, decMin int, decSec float64) {
 	lDecimalDeg := macros.ConvertDegMinSecToDecimalDeg(lHrs, lMin, lSec)
 	bDecimalDeg := macros.ConvertDegMinSecToDecimalDeg(bDeg, bMin, bSec)
-	decDecimalDeg := macros.ConvertRadianceToDegree(math.Asin((math.Cos(macros.ConvertDegreesToRadiance(bDecimalDeg)) * math.Cos(macros.ConvertDegreesToRadiance(27.4)) * math.Sin(macros.ConvertDegreesToRadiance(lDecimalDeg)-macros.ConvertDegreesToRadiance(33.0))) + (math.Sin(macros.ConvertDegreesToRadiance(bDecimalDeg)) * math.Sin(macros.ConvertDegreesToRadiance(27.4)))))
 
-	y := math.Cos(macros.ConvertDegreesToRadiance(bDecimalDeg)) * math.Cos(macros.ConvertDegreesToRadiance(lDecimalDeg-33.0))
-	x := (math.Sin(macros.ConvertDegreesToRadiance(bDecimalDeg)) * math.Cos(macros.ConvertDegreesToRadiance(27.4))) - (math.Cos(macros.ConvertDegreesToRadiance(bDecimalDeg)) * math.Sin(macros.ConvertDegreesToRadiance(27.4)) * math.Sin(macros.ConvertDegreesToRadiance(lDecimalDeg-33.0)))
-	raDecimalDeg := macros.AdjustAngleRange(macros.ConvertRadianceToDegree(math.Atan2(y, x))+192.25, 0, 360)
+	raDecimalDeg, decDecimalDeg := equatorialToGalacticMatrix.Transpose().ApplyDecimalDeg(lDecimalDeg, bDecimalDeg)
 
 	raHrs, raMin, raSec = datetime.ConvertDecimalHrsToHrsMinSec(macros.ConvertDecimalDegressToDecimalHrs(raDecimalDeg))
 	decDeg, decMin, decSec = macros.ConvertDecimalDegToDegMinSec(decDecimalDeg)
@@ -197,20 +152,23 @@ func CalculateRisingAndSettingTime(Gday float64, Gmonth, Gyear, raHrs, raMin int
 }
 
 func CalculatePrecession(n1, n2 float64, alphaHrs, alphaMin int, alphaSec float64, deltaDeg, deltaMin int, deltaSec float64) (alpha1Hrs, alpha1Min int, alpha1Sec float64, delta1Deg, delta1Min int, delta1Sec float64) {
+	// Rigorous IAU 2006 precession of a position from the epoch n2 to the epoch n1, both in Julian years such as
+	// 1950.0, which stays valid near the poles unlike the annual rates it replaces
 	decimalHrs := datetime.ConvertHrsMinSecToDecimalHrs(alphaHrs, alphaMin, alphaSec, false, false)
-	decimalHrsTodeg := ConvertDecimalHrsToDecimalDegress(decimalHrs)
 	decimalDeg := macros.ConvertDegMinSecToDecimalDeg(deltaDeg, deltaMin, deltaSec)
 
-	S1Hrs := ((3.07327 + (1.33617 * math.Sin(macros.ConvertDegreesToRadiance(decimalHrsTodeg)) * math.Tan(macros.ConvertDegreesToRadiance(decimalDeg)))) * (n1 - n2)) / 3600 // Convert to Hrs
-	S2Deg := ((20.0426 * math.Cos(macros.ConvertDegreesToRadiance(decimalHrsTodeg))) * (n1 - n2)) / 3600
+	coordinates := EquatorialCoordinates{RADecimalHrs: decimalHrs, DecDecimalDeg: decimalDeg}
+	precessed := coordinates.Precess(2451545.0+((n2-2000.0)*365.25), 2451545.0+((n1-2000.0)*365.25))
 
-	alpha1Hrs, alpha1Min, alpha1Sec = datetime.ConvertDecimalHrsToHrsMinSec(decimalHrs + S1Hrs)
-	delta1Deg, delta1Min, delta1Sec = macros.ConvertDecimalDegToDegMinSec(decimalDeg + S2Deg)
+	alpha1Hrs, alpha1Min, alpha1Sec = datetime.ConvertDecimalHrsToHrsMinSec(precessed.RADecimalHrs)
+	delta1Deg, delta1Min, delta1Sec = macros.ConvertDecimalDegToDegMinSec(precessed.DecDecimalDeg)
 
 	return alpha1Hrs, alpha1Min, alpha1Sec, delta1Deg, delta1Min, delta1Sec
 }
 
 func CalculateNutation(day float64, month, year int) (float64, float64) {
+	// Two-term nutation in longitude and in obliquity in arcseconds, good to about 0.5"; CalculateNutationIAU1980
+	// and CalculateNutationIAU2000B give decimal degrees from the full series
 	julianDate := datetime.ConvertGreenwichDateToJulianDate(day, month, year)
 	T := (julianDate - 2415020.0) / 36525.0
 	A := 100.002136 * T
@@ -243,11 +201,7 @@ func CalculateAberration(day float64, month, year, trueLambdaDeg, trueLambdaMin
 func CalculateRefraction(trueHAHr, trueHAMin int, trueHASec float64, trueDecDeg, trueDecMin int, trueDecSec float64, geoLat, temp, pressure float64) (HaHrs, HaMin int, HaSec float64, DecDeg, DecMin int, DecSec float64) {
 	altitudeDeg, altitudeMin, altitudeSec, azimuthDeg, azimuthMin, azimuthSec := ConvertEquatorialToHorizonCoordinates(trueHAHr, trueHAMin, trueHASec, trueDecDeg, trueDecMin, trueDecSec, geoLat)
 	altitudeDecimalDeg := macros.ConvertDegMinSecToDecimalDeg(altitudeDeg, altitudeMin, altitudeSec)
-	R := 0.0
-	z := 90 - altitudeDecimalDeg
-	if altitudeDecimalDeg > 15.0 {
-		R = (0.00452 * pressure * math.Tan(macros.ConvertDegreesToRadiance(z))) / (273 + temp)
-	}
+	R := CalculateRefractionDecimalDeg(altitudeDecimalDeg, temp, pressure)
 
 	apperentAltDeg, apperentAltMin, apperentAltSec := macros.ConvertDecimalDegToDegMinSec(R + altitudeDecimalDeg)
 
@@ -256,6 +210,15 @@ func CalculateRefraction(trueHAHr, trueHAMin int, trueHASec float64, trueDecDeg,
 	return HaHrs, HaMin, HaSec, DecDeg, DecMin, DecSec
 }
 
+func CalculateRefractionDecimalDeg(altitude, temp, pressure float64) float64 {
+	// Refraction in decimal degrees to add to a geometric altitude, temperature in degrees Celsius and pressure in
+	// millibars, with the low altitude formula below 15 degrees (Duffett-Smith chapter 37)
+	if altitude > 15.0 {
+		return (0.00452 * pressure * math.Tan(macros.ConvertDegreesToRadiance(90-altitude))) / (273 + temp)
+	}
+	return (pressure * (0.1594 + (0.0196 * altitude) + (0.00002 * math.Pow(altitude, 2)))) / ((273 + temp) * (1 + (0.505 * altitude) + (0.0845 * math.Pow(altitude, 2))))
+}
+
 func CalculateGeocentricParallax(heightFromSeaLevel, longW, latN float64) (float64, float64) {
 	u := macros.ConvertRadianceToDegree(math.Atan(0.996647 * math.Tan(macros.ConvertDegreesToRadiance(latN))))
 	hInv := heightFromSeaLevel / 6378140
@@ -372,9 +335,9 @@ func CalculateSelenographicCoordinatesOfMoon(Gday float64, GMonth, GYear int, mo
 
 	x := (math.Cos(macros.ConvertDegreesToRadiance(moonGeoLatDecimalDeg)) * math.Cos(macros.ConvertDegreesToRadiance(deltaOmegaDeg-moonGeoLongDecimalDeg)))
 
-	A := macros.AdjustAngleInQuadrant(x, y, macros.ConvertRadianceToDegree(math.Atan(y/x)))
+	A := macros.ConvertRadianceToDegree(math.Atan2(y, x))
 
-	le = macros.AdjustAngleRange(A-FDeg, -180, 180)
+	le = math.Remainder(A-FDeg, 360)
 
 	C1 := macros.ConvertRadianceToDegree(math.Atan((math.Cos(macros.ConvertDegreesToRadiance(deltaOmegaDeg-moonGeoLongDecimalDeg)) * math.Sin(macros.ConvertDegreesToRadiance(Ideg))) / ((math.Cos(macros.ConvertDegreesToRadiance(moonGeoLatDecimalDeg)) * math.Cos(macros.ConvertDegreesToRadiance(Ideg))) + (math.Sin(macros.ConvertDegreesToRadiance(moonGeoLatDecimalDeg)) * math.Sin(macros.ConvertDegreesToRadiance(Ideg)) * math.Sin(macros.ConvertDegreesToRadiance(deltaOmegaDeg-moonGeoLongDecimalDeg))))))
 	C2 := macros.ConvertRadianceToDegree(math.Atan((math.Sin(macros.ConvertDegreesToRadiance(obliquity)) * math.Cos(macros.ConvertDegreesToRadiance(moonGeoLongDecimalDeg))) / ((math.Sin(macros.ConvertDegreesToRadiance(obliquity)) * math.Sin(macros.ConvertDegreesToRadiance(moonGeoLatDecimalDeg)) * math.Sin(macros.ConvertDegreesToRadiance(moonGeoLongDecimalDeg))) - (math.Cos(macros.ConvertDegreesToRadiance(obliquity)) * math.Cos(macros.ConvertDegreesToRadiance(moonGeoLatDecimalDeg))))))
@@ -390,3 +353,102 @@ func CalculateSelenographicCoordinatesOfSun(Gday float64, GMonth, GYear int, UTH
 
 	return ls, bs, colongitude
 }
+
+func ConvertEclipticDecimalDegToEquatorial(lambda, beta, obliquity float64) (raDecimalDeg, decDecimalDeg float64) {
+	raDecimalDeg, decDecimalDeg = CalculateEquatorialToEclipticMatrix(obliquity).Transpose().ApplyDecimalDeg(lambda, beta)
+	return raDecimalDeg, decDecimalDeg
+}
+
+func ConvertEquatorialDecimalDegToEcliptic(raDecimalDeg, decDecimalDeg, obliquity float64) (lambda, beta float64) {
+	lambda, beta = CalculateEquatorialToEclipticMatrix(obliquity).ApplyDecimalDeg(raDecimalDeg, decDecimalDeg)
+	return lambda, beta
+}
+
+func ConvertHourAngleDecimalDegToHorizon(hourAngleDeg, decDecimalDeg, geoLatN float64) (altitude, azimuth float64) {
+	// Azimuth is measured from the north point eastwards
+	azimuth, altitude = CalculateHourAngleToHorizonMatrix(geoLatN).ApplyDecimalDeg(hourAngleDeg, decDecimalDeg)
+	return altitude, azimuth
+}
+
+func CalculateAberrationDecimalDeg(julianEphemerisDate, lambda, beta, sunTrueLongitude float64) (deltaLambda, deltaBeta float64) {
+	// Annual aberration in ecliptic longitude and latitude (decimal degrees) including the e-terms (Meeus 23.2)
+	T := (julianEphemerisDate - 2451545.0) / 36525.0
+	kappa := 20.49552 / 3600
+	e := 0.016708634 - (0.000042037 * T) - (0.0000001267 * math.Pow(T, 2))
+	perihelion := macros.ConvertDegreesToRadiance(102.93735 + (1.71946 * T) + (0.00046 * math.Pow(T, 2)))
+	lambdaRad := macros.ConvertDegreesToRadiance(lambda)
+	betaRad := macros.ConvertDegreesToRadiance(beta)
+	sunRad := macros.ConvertDegreesToRadiance(sunTrueLongitude)
+
+	deltaLambda = ((-kappa * math.Cos(sunRad-lambdaRad)) + (e * kappa * math.Cos(perihelion-lambdaRad))) / math.Cos(betaRad)
+	deltaBeta = -kappa * math.Sin(betaRad) * (math.Sin(sunRad-lambdaRad) - (e * math.Sin(perihelion-lambdaRad)))
+
+	return deltaLambda, deltaBeta
+}
+
+func ConvertGeocentricToTopocentricDecimalDeg(raDecimalDeg, decDecimalDeg, distanceEarthRadii, hourAngleDeg, pSin, pCos float64) (topoRADecimalDeg, topoDecDecimalDeg, topoDistanceEarthRadii float64) {
+	// Rigorous parallax correction, pSin and pCos are the observer's geocentric coordinates from CalculateGeocentricParallax
+	hourAngleRad := macros.ConvertDegreesToRadiance(hourAngleDeg)
+	decRad := macros.ConvertDegreesToRadiance(decDecimalDeg)
+
+	x := (distanceEarthRadii * math.Cos(decRad) * math.Cos(hourAngleRad)) - pCos
+	y := distanceEarthRadii * math.Cos(decRad) * math.Sin(hourAngleRad)
+	z := (distanceEarthRadii * math.Sin(decRad)) - pSin
+
+	topoDistanceEarthRadii = math.Sqrt(math.Pow(x, 2) + math.Pow(y, 2) + math.Pow(z, 2))
+	topoDecDecimalDeg = macros.ConvertRadianceToDegree(math.Asin(z / topoDistanceEarthRadii))
+	topoHourAngleDeg := macros.ConvertRadianceToDegree(math.Atan2(y, x))
+	topoRADecimalDeg = macros.AdjustAngleRange(raDecimalDeg-(topoHourAngleDeg-hourAngleDeg), 0, 360)
+
+	return topoRADecimalDeg, topoDecDecimalDeg, topoDistanceEarthRadii
+}
+
+func CalculateGreenwichApparentSiderealTime(julianDate float64) float64 {
+	// Mean sidereal time corrected by the equation of the equinoxes, in decimal degrees
+	_, _, _, gst := datetime.ConvertJulianDateToGreenwichSiderealTime(julianDate)
+	equationOfEquinoxes := CalculateEquationOfEquinoxes(datetime.ConvertUniversalTimeToEphemerisTime(julianDate))
+
+	return macros.AdjustAngleRange((gst*15)+equationOfEquinoxes, 0, 360)
+}
+
+func CalculateAngularSeparationDecimalDeg(ra1, dec1, ra2, dec2 float64) float64 {
+	// Angular separation of two bodies in decimal degrees, accurate for very small angles
+	ra1Rad, dec1Rad := macros.ConvertDegreesToRadiance(ra1), macros.ConvertDegreesToRadiance(dec1)
+	ra2Rad, dec2Rad := macros.ConvertDegreesToRadiance(ra2), macros.ConvertDegreesToRadiance(dec2)
+
+	x := (math.Cos(dec1Rad) * math.Sin(dec2Rad)) - (math.Sin(dec1Rad) * math.Cos(dec2Rad) * math.Cos(ra2Rad-ra1Rad))
+	y := math.Cos(dec2Rad) * math.Sin(ra2Rad-ra1Rad)
+	z := (math.Sin(dec1Rad) * math.Sin(dec2Rad)) + (math.Cos(dec1Rad) * math.Cos(dec2Rad) * math.Cos(ra2Rad-ra1Rad))
+
+	return macros.ConvertRadianceToDegree(math.Atan2(math.Sqrt(math.Pow(x, 2)+math.Pow(y, 2)), z))
+}
+
+func CalculatePositionAngleDecimalDeg(ra1, dec1, ra2, dec2 float64) float64 {
+	// Position angle of the second body with respect to the first, measured from the north point through east
+	ra1Rad, dec1Rad := macros.ConvertDegreesToRadiance(ra1), macros.ConvertDegreesToRadiance(dec1)
+	ra2Rad, dec2Rad := macros.ConvertDegreesToRadiance(ra2), macros.ConvertDegreesToRadiance(dec2)
+
+	y := math.Cos(dec2Rad) * math.Sin(ra2Rad-ra1Rad)
+	x := (math.Cos(dec1Rad) * math.Sin(dec2Rad)) - (math.Sin(dec1Rad) * math.Cos(dec2Rad) * math.Cos(ra2Rad-ra1Rad))
+
+	return macros.AdjustAngleRange(macros.ConvertRadianceToDegree(math.Atan2(y, x)), 0, 360)
+}
+
+func CalculateEclipticPrecessionDecimalDeg(lambda, beta, julianEphemerisDate, epochJulianEphemerisDate float64) (precessedLambda, precessedBeta float64) {
+	// Rigorous precession of ecliptic coordinates from the ecliptic and equinox of one date to those of another
+	// (Meeus 21.5)
+	T := (julianEphemerisDate - 2451545.0) / 36525.0
+	t := (epochJulianEphemerisDate - julianEphemerisDate) / 36525.0
+	eta := ((((47.0029 - (0.06603 * T) + (0.000598 * math.Pow(T, 2))) * t) + ((-0.03302 + (0.000598 * T)) * math.Pow(t, 2)) + (0.000060 * math.Pow(t, 3))) / 3600)
+	PI := 174.876384 + (((3289.4789 * T) + (0.60622 * math.Pow(T, 2)) - ((869.8089 + (0.50491 * T)) * t) + (0.03536 * math.Pow(t, 2))) / 3600)
+	p := (((5029.0966 + (2.22226 * T) - (0.000042 * math.Pow(T, 2))) * t) + ((1.11113 - (0.000042 * T)) * math.Pow(t, 2)) - (0.000006 * math.Pow(t, 3))) / 3600
+
+	etaRad, PIRad := macros.ConvertDegreesToRadiance(eta), macros.ConvertDegreesToRadiance(PI)
+	lambdaRad, betaRad := macros.ConvertDegreesToRadiance(lambda), macros.ConvertDegreesToRadiance(beta)
+	A := (math.Cos(etaRad) * math.Cos(betaRad) * math.Sin(PIRad-lambdaRad)) - (math.Sin(etaRad) * math.Sin(betaRad))
+	B := math.Cos(betaRad) * math.Cos(PIRad-lambdaRad)
+	C := (math.Cos(etaRad) * math.Sin(betaRad)) + (math.Sin(etaRad) * math.Cos(betaRad) * math.Sin(PIRad-lambdaRad))
+	precessedLambda = macros.AdjustAngleRange(p+PI-macros.ConvertRadianceToDegree(math.Atan2(A, B)), 0, 360)
+	precessedBeta = macros.ConvertRadianceToDegree(math.Asin(C))
+	return precessedLambda, precessedBeta
+}
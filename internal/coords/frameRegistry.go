@@ -0,0 +1,142 @@
+package coords
+
+import (
+	datetime "go-astronomy/internal/dateTime"
+	vecmat "go-astronomy/internal/vecMat"
+)
+
+// Galactic coordinates defined on the ICRS (Hipparcos catalogue, volume 1, section 1.5.3)
+const FrameGalactic ReferenceFrame = "Galactic"
+
+// Supergalactic coordinates of de Vaucouleurs, whose pole lies at galactic longitude 47.37 and latitude +6.32
+const FrameSupergalactic ReferenceFrame = "Supergalactic"
+
+// Geocentric coordinates referred to the mean ecliptic and equinox of J2000.0
+const FrameEclipticJ2000 ReferenceFrame = "EclipticJ2000"
+
+// Geocentric coordinates referred to the mean ecliptic and equinox of the date of the position
+const FrameEclipticOfDate ReferenceFrame = "EclipticOfDate"
+
+// Mean obliquity of the ecliptic at J2000.0 in decimal degrees (IAU 2006)
+const obliquityJ2000 = 84381.406 / 3600
+
+// FrameDefinition tells the registry how to reach the ICRS from a frame. Both functions work on Cartesian
+// positions at a Julian ephemeris date and must undo each other. Origin gives the position of the origin of the
+// frame with respect to the centre of the Earth in AU along ICRS axes, and is nil for geocentric frames.
+type FrameDefinition struct {
+	ToICRS   func(position vecmat.Vec3, julianEphemerisDate float64) vecmat.Vec3
+	FromICRS func(position vecmat.Vec3, julianEphemerisDate float64) vecmat.Vec3
+	Origin   func(julianEphemerisDate float64) vecmat.Vec3
+}
+
+// SphericalPosition holds a longitude and latitude in decimal degrees in some frame and, for bodies of the solar
+// system, a distance in AU. A zero distance marks the direction of a star or galaxy, which a change of origin
+// leaves alone.
+type SphericalPosition struct {
+	Longitude  float64
+	Latitude   float64
+	DistanceAU float64
+	Frame      ReferenceFrame
+}
+
+var frameRegistry = map[ReferenceFrame]FrameDefinition{
+	FrameICRS: CalculateRotationFrameDefinition(func(float64) vecmat.Mat3 { return vecmat.Identity }),
+	FrameFK5: CalculateRotationFrameDefinition(func(julianEphemerisDate float64) vecmat.Mat3 {
+		return CalculateFK5ToICRSMatrix(julianEphemerisDate).Transpose()
+	}),
+	FrameFK4: {
+		ToICRS: func(position vecmat.Vec3, julianEphemerisDate float64) vecmat.Vec3 {
+			fk5 := convertFK4ToFK5(position.Normalize(), datetime.ConvertJulianDateToBesselianEpoch(julianEphemerisDate))
+			return CalculateFK5ToICRSMatrix(julianEphemerisDate).Apply(fk5).Scale(position.Length())
+		},
+		FromICRS: func(position vecmat.Vec3, julianEphemerisDate float64) vecmat.Vec3 {
+			fk5 := CalculateFK5ToICRSMatrix(julianEphemerisDate).Transpose().Apply(position.Normalize())
+			return convertFK5ToFK4(fk5, datetime.ConvertJulianDateToBesselianEpoch(julianEphemerisDate)).Scale(position.Length())
+		},
+	},
+	FrameMeanOfDate: CalculateRotationFrameDefinition(CalculateBiasPrecessionMatrixIAU2006),
+	FrameGalactic: CalculateRotationFrameDefinition(func(float64) vecmat.Mat3 {
+		return icrsToGalacticMatrix
+	}),
+	FrameSupergalactic: CalculateRotationFrameDefinition(func(float64) vecmat.Mat3 {
+		return icrsToSupergalacticMatrix
+	}),
+	FrameEclipticJ2000:  CalculateRotationFrameDefinition(CalculateICRSToEclipticJ2000Matrix),
+	FrameEclipticOfDate: CalculateRotationFrameDefinition(CalculateICRSToEclipticOfDateMatrix),
+}
+
+var icrsToGalacticMatrix = CalculatePoleMatrix(192.85948, 27.12825, 32.93192)
+var galacticToSupergalacticMatrix = CalculatePoleMatrix(47.37, 6.32, 0)
+var icrsToSupergalacticMatrix = icrsToGalacticMatrix.Then(galacticToSupergalacticMatrix)
+var icrsToEclipticJ2000Matrix = CalculateBiasPrecessionMatrixIAU2006(2451545.0).Then(CalculateEquatorialToEclipticMatrix(obliquityJ2000))
+
+func CalculateICRSToEclipticJ2000Matrix(float64) vecmat.Mat3 {
+	// Rotation matrix from the ICRS to the mean ecliptic and equinox of J2000.0, the same at every date
+	return icrsToEclipticJ2000Matrix
+}
+
+func CalculateICRSToEclipticOfDateMatrix(julianEphemerisDate float64) vecmat.Mat3 {
+	// Rotation matrix from the ICRS to the mean ecliptic and equinox of date, frame bias and IAU 2006 precession
+	// followed by the mean obliquity
+	_, _, _, meanObliquity := CalculateFukushimaWilliamsAngles(julianEphemerisDate)
+	return CalculateBiasPrecessionMatrixIAU2006(julianEphemerisDate).Then(CalculateEquatorialToEclipticMatrix(meanObliquity))
+}
+
+func ConvertGalacticDecimalDegToSupergalactic(l, b float64) (sgl, sgb float64) {
+	// Supergalactic longitude and latitude SGL, SGB of a galactic longitude and latitude, all in decimal degrees
+	return galacticToSupergalacticMatrix.ApplyDecimalDeg(l, b)
+}
+
+func ConvertSupergalacticDecimalDegToGalactic(sgl, sgb float64) (l, b float64) {
+	return galacticToSupergalacticMatrix.Transpose().ApplyDecimalDeg(sgl, sgb)
+}
+
+func CalculateRotationFrameDefinition(icrsToFrameMatrix func(julianEphemerisDate float64) vecmat.Mat3) FrameDefinition {
+	// Definition of a geocentric frame turned from the ICRS by a rotation matrix
+	return FrameDefinition{
+		ToICRS: func(position vecmat.Vec3, julianEphemerisDate float64) vecmat.Vec3 {
+			return icrsToFrameMatrix(julianEphemerisDate).Transpose().Apply(position)
+		},
+		FromICRS: func(position vecmat.Vec3, julianEphemerisDate float64) vecmat.Vec3 {
+			return icrsToFrameMatrix(julianEphemerisDate).Apply(position)
+		},
+	}
+}
+
+func RegisterFrame(frame ReferenceFrame, definition FrameDefinition) {
+	// Adds a frame to the registry, or replaces one. Registration belongs in init functions since the registry is
+	// not guarded against concurrent use.
+	frameRegistry[frame] = definition
+}
+
+func (position SphericalPosition) ConvertToFrame(frame ReferenceFrame, julianEphemerisDate float64) (SphericalPosition, error) {
+	// Position in any registered frame from any other, through the ICRS. The origin is only moved when there is a
+	// distance, light time and aberration are left to the caller.
+	from, isFromKnown := frameRegistry[position.Frame]
+	to, isToKnown := frameRegistry[frame]
+	if !isFromKnown || !isToKnown {
+		return SphericalPosition{}, ErrUnknownFrame
+	}
+
+	vector := vecmat.ConvertSphericalDecimalDegToVec3(position.Longitude, position.Latitude)
+	if position.DistanceAU > 0 {
+		vector = vector.Scale(position.DistanceAU)
+	}
+	vector = from.ToICRS(vector, julianEphemerisDate)
+	if position.DistanceAU > 0 {
+		if from.Origin != nil {
+			vector = vector.Add(from.Origin(julianEphemerisDate))
+		}
+		if to.Origin != nil {
+			vector = vector.Subtract(to.Origin(julianEphemerisDate))
+		}
+	}
+	vector = to.FromICRS(vector, julianEphemerisDate)
+
+	converted := SphericalPosition{Frame: frame}
+	converted.Longitude, converted.Latitude = vector.ConvertToSphericalDecimalDeg()
+	if position.DistanceAU > 0 {
+		converted.DistanceAU = vector.Length()
+	}
+	return converted, nil
+}
@@ -0,0 +1,51 @@
+package coords
+
+import (
+	vecmat "go-astronomy/internal/vecMat"
+)
+
+// Galactic pole and the galactic longitude of the ascending node of the galactic plane on the equator, referred to
+// the mean equator and equinox of B1950.0 (Meeus chapter 13)
+const galacticPoleRAB1950 = 192.25
+const galacticPoleDecB1950 = 27.4
+const galacticNodeLongitudeB1950 = 33.0
+
+// Fixed frame rotations are built once and reused
+var equatorialToGalacticMatrix = CalculatePoleMatrix(galacticPoleRAB1950, galacticPoleDecB1950, galacticNodeLongitudeB1950)
+
+// Turns the hour angle frame, in which hour angles grow westwards, into one in which right ascensions grow eastwards
+var hourAngleReflection = vecmat.Mat3{{1, 0, 0}, {0, -1, 0}, {0, 0, 1}}
+
+func CalculatePoleMatrix(poleLongitude, poleLatitude, nodeLongitude float64) vecmat.Mat3 {
+	// Rotation matrix into a frame given by the position of its pole and by the longitude, in the new frame, of the
+	// ascending node of its equator on the old one. All angles in decimal degrees.
+	return vecmat.CalculateRotationZ(poleLongitude + 90).Then(vecmat.CalculateRotationX(90 - poleLatitude)).Then(vecmat.CalculateRotationZ(-nodeLongitude))
+}
+
+func CalculateEquatorialToEclipticMatrix(obliquity float64) vecmat.Mat3 {
+	// Rotation matrix from equatorial to ecliptic coordinates of the same equinox, obliquity in decimal degrees
+	return vecmat.CalculateRotationX(obliquity)
+}
+
+func CalculateEquatorialToGalacticMatrix() vecmat.Mat3 {
+	// Rotation matrix from equatorial coordinates of B1950.0 to galactic coordinates
+	return equatorialToGalacticMatrix
+}
+
+func CalculateEquatorialToHourAngleMatrix(localSiderealTimeDeg float64) vecmat.Mat3 {
+	// Matrix from right ascension and declination to hour angle and declination, the hour angle being the local
+	// sidereal time less the right ascension. The matrix is its own inverse.
+	return vecmat.CalculateRotationZ(localSiderealTimeDeg).Then(hourAngleReflection)
+}
+
+func CalculateHourAngleToHorizonMatrix(geoLatN float64) vecmat.Mat3 {
+	// Rotation matrix from hour angle and declination to azimuth, measured from the north point eastwards, and
+	// altitude
+	return vecmat.CalculateRotationY(90 - geoLatN).Then(vecmat.CalculateRotationZ(180))
+}
+
+func CalculatePrecessionNutationMatrix(julianEphemerisDate float64) vecmat.Mat3 {
+	// Rotation matrix from the GCRS to the true equator and equinox of date, frame bias, precession and nutation
+	// composed into one
+	return CalculateBiasPrecessionMatrixIAU2006(julianEphemerisDate).Then(CalculateNutationMatrix(julianEphemerisDate))
+}
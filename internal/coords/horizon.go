@@ -0,0 +1,153 @@
+package coords
+
+import (
+	"errors"
+	datetime "go-astronomy/internal/dateTime"
+	"go-astronomy/internal/macros"
+	"math"
+)
+
+var ErrAtZenith = errors.New("azimuth and parallactic angle rates are undefined at the zenith")
+
+// Rotation of the Earth against the stars in decimal degrees per second of Universal Time
+const siderealRateDegPerSecond = 360.98564736629 / 86400
+
+// Closest approach to the zenith, as the cosine of the altitude, for which rates are still computed
+const zenithCosineLimit = 1e-10
+
+// AzimuthOrigin selects the point of the horizon azimuths are counted from. Azimuths from the north grow through
+// the east and those from the south, as in Meeus chapter 13, through the west, so that one is the other less 180
+// degrees.
+type AzimuthOrigin int
+
+const (
+	AzimuthFromNorth AzimuthOrigin = iota
+	AzimuthFromSouth
+)
+
+// Observer is a place on the Earth, latitude and longitude in decimal degrees with the longitude positive east
+// of Greenwich, and height above sea level in metres
+type Observer struct {
+	GeoLatN            float64
+	GeoLong            float64
+	HeightFromSeaLevel float64
+}
+
+// Instant is a moment given by its Julian date in Universal Time
+type Instant struct {
+	JulianDate float64
+}
+
+// HorizonCoordinates holds the place of a body in the sky of an observer, all in decimal degrees. The altitude is
+// geometric, the hour angle runs from -180 to 180 degrees and is positive west of the meridian, and the
+// parallactic angle is the angle at the body from the direction of the north celestial pole to that of the zenith,
+// positive west of the meridian.
+type HorizonCoordinates struct {
+	Altitude         float64
+	Azimuth          float64
+	AzimuthOrigin    AzimuthOrigin
+	HourAngle        float64
+	ParallacticAngle float64
+}
+
+// HorizonRates holds the rates of change of the horizon coordinates of a body carried by the rotation of the Earth,
+// in decimal degrees per second of time. The rate of the parallactic angle is the field rotation seen by an
+// alt-azimuth telescope, which an instrument derotator has to take out. Azimuth rates are the same for both
+// azimuth origins.
+type HorizonRates struct {
+	AltitudeRate         float64
+	AzimuthRate          float64
+	ParallacticAngleRate float64
+}
+
+func (instant Instant) CalculateJulianEphemerisDate() float64 {
+	return datetime.ConvertUniversalTimeToEphemerisTime(instant.JulianDate)
+}
+
+func (observer Observer) CalculateLocalApparentSiderealTime(instant Instant) float64 {
+	// Apparent sidereal time on the meridian of the observer in decimal degrees
+	return macros.AdjustAngleRange(CalculateGreenwichApparentSiderealTime(instant.JulianDate)+observer.GeoLong, 0, 360)
+}
+
+func adjustHourAngleRange(hourAngleDeg float64) float64 {
+	// Hour angle brought between -180 and 180 degrees
+	return hourAngleDeg - (360 * math.Round(hourAngleDeg/360))
+}
+
+func CalculateParallacticAngle(hourAngleDeg, decDecimalDeg, geoLatN float64) float64 {
+	// Parallactic angle in decimal degrees (Meeus 14.1), atan2 keeping it in the right quadrant. It is undefined at
+	// the zenith itself, where zero is returned.
+	hourAngleRad := macros.ConvertDegreesToRadiance(hourAngleDeg)
+	decRad := macros.ConvertDegreesToRadiance(decDecimalDeg)
+	latRad := macros.ConvertDegreesToRadiance(geoLatN)
+
+	y := math.Sin(hourAngleRad) * math.Cos(latRad)
+	x := (math.Sin(latRad) * math.Cos(decRad)) - (math.Cos(latRad) * math.Sin(decRad) * math.Cos(hourAngleRad))
+	if y == 0 && x == 0 {
+		return 0
+	}
+	return macros.ConvertRadianceToDegree(math.Atan2(y, x))
+}
+
+func ConvertHourAngleDecimalDegToHorizonCoordinates(hourAngleDeg, decDecimalDeg, geoLatN float64, origin AzimuthOrigin) HorizonCoordinates {
+	// Horizon coordinates of a body from its hour angle and declination, all in decimal degrees
+	horizon := HorizonCoordinates{AzimuthOrigin: origin}
+	horizon.HourAngle = adjustHourAngleRange(hourAngleDeg)
+	horizon.Altitude, horizon.Azimuth = ConvertHourAngleDecimalDegToHorizon(horizon.HourAngle, decDecimalDeg, geoLatN)
+	if origin == AzimuthFromSouth {
+		horizon.Azimuth = macros.AdjustAngleRange(horizon.Azimuth-180, 0, 360)
+	}
+	horizon.ParallacticAngle = CalculateParallacticAngle(horizon.HourAngle, decDecimalDeg, geoLatN)
+	return horizon
+}
+
+func ConvertEquatorialToHorizon(raDecimalHrs, decDecimalDeg float64, observer Observer, instant Instant, origin AzimuthOrigin) HorizonCoordinates {
+	// Horizon coordinates of a body from its right ascension and declination, referred to the true equator and
+	// equinox of date, for an observer at an instant. The hour angle is taken from the local apparent sidereal
+	// time.
+	hourAngleDeg := observer.CalculateLocalApparentSiderealTime(instant) - (raDecimalHrs * 15)
+	return ConvertHourAngleDecimalDegToHorizonCoordinates(hourAngleDeg, decDecimalDeg, observer.GeoLatN, origin)
+}
+
+func ConvertHorizonToHourAngle(altitude, azimuth float64, origin AzimuthOrigin, geoLatN float64) (hourAngleDeg, decDecimalDeg float64) {
+	// Hour angle, from -180 to 180 degrees, and declination of a point of the sky of an observer, all in decimal
+	// degrees
+	if origin == AzimuthFromSouth {
+		azimuth += 180
+	}
+	hourAngleDeg, decDecimalDeg = CalculateHourAngleToHorizonMatrix(geoLatN).Transpose().ApplyDecimalDeg(azimuth, altitude)
+	return adjustHourAngleRange(hourAngleDeg), decDecimalDeg
+}
+
+func ConvertHorizonToEquatorial(altitude, azimuth float64, origin AzimuthOrigin, observer Observer, instant Instant) (raDecimalHrs, decDecimalDeg float64) {
+	// Right ascension and declination, referred to the true equator and equinox of date, of a point of the sky of an
+	// observer at an instant
+	hourAngleDeg, decDecimalDeg := ConvertHorizonToHourAngle(altitude, azimuth, origin, observer.GeoLatN)
+	raDecimalDeg := macros.AdjustAngleRange(observer.CalculateLocalApparentSiderealTime(instant)-hourAngleDeg, 0, 360)
+	return raDecimalDeg / 15, decDecimalDeg
+}
+
+func CalculateHorizonRates(hourAngleDeg, decDecimalDeg, geoLatN float64) (HorizonRates, error) {
+	// Rates of altitude, azimuth and parallactic angle of a body fixed on the sky from its hour angle and declination
+	// in decimal degrees. The azimuth and parallactic angle rates grow without bound as the body nears the zenith,
+	// through which they jump by 180 degrees, so ErrAtZenith is returned with only the altitude rate there.
+	horizon := ConvertHourAngleDecimalDegToHorizonCoordinates(hourAngleDeg, decDecimalDeg, geoLatN, AzimuthFromNorth)
+	azimuthRad := macros.ConvertDegreesToRadiance(horizon.Azimuth)
+	altitudeRad := macros.ConvertDegreesToRadiance(horizon.Altitude)
+	latRad := macros.ConvertDegreesToRadiance(geoLatN)
+
+	rates := HorizonRates{AltitudeRate: siderealRateDegPerSecond * math.Cos(latRad) * math.Sin(azimuthRad)}
+	if math.Cos(altitudeRad) < zenithCosineLimit {
+		return rates, ErrAtZenith
+	}
+	rates.AzimuthRate = siderealRateDegPerSecond * (math.Sin(latRad) - (math.Cos(latRad) * math.Cos(azimuthRad) * math.Tan(altitudeRad)))
+	rates.ParallacticAngleRate = -siderealRateDegPerSecond * math.Cos(latRad) * math.Cos(azimuthRad) / math.Cos(altitudeRad)
+	return rates, nil
+}
+
+func CalculateTrackingRates(raDecimalHrs, decDecimalDeg float64, observer Observer, instant Instant) (HorizonRates, error) {
+	// Rates at which an alt-azimuth mount and its derotator follow a body whose right ascension and declination are
+	// referred to the true equator and equinox of date
+	hourAngleDeg := observer.CalculateLocalApparentSiderealTime(instant) - (raDecimalHrs * 15)
+	return CalculateHorizonRates(hourAngleDeg, decDecimalDeg, observer.GeoLatN)
+}
@@ -0,0 +1,93 @@
+package coords
+
+import (
+	"go-astronomy/internal/macros"
+	vecmat "go-astronomy/internal/vecMat"
+	"math"
+)
+
+func CalculateNutationIAU1980(julianEphemerisDate float64) (nutationInLongitude, nutationInObliquity float64) {
+	// Nutation in longitude and in obliquity in decimal degrees by the IAU 1980 theory (Meeus chapter 22), accurate
+	// to about 0.001"
+	T := (julianEphemerisDate - 2451545.0) / 36525.0
+	D := 297.85036 + (445267.111480 * T) - (0.0019142 * math.Pow(T, 2)) + (math.Pow(T, 3) / 189474)
+	M := 357.52772 + (35999.050340 * T) - (0.0001603 * math.Pow(T, 2)) - (math.Pow(T, 3) / 300000)
+	MDash := 134.96298 + (477198.867398 * T) + (0.0086972 * math.Pow(T, 2)) + (math.Pow(T, 3) / 56250)
+	F := 93.27191 + (483202.017538 * T) - (0.0036825 * math.Pow(T, 2)) + (math.Pow(T, 3) / 327270)
+	omega := 125.04452 - (1934.136261 * T) + (0.0020708 * math.Pow(T, 2)) + (math.Pow(T, 3) / 450000)
+
+	sumLongitude, sumObliquity := 0.0, 0.0
+	for _, term := range nutationTermsIAU1980 {
+		argument := macros.ConvertDegreesToRadiance((term[0] * D) + (term[1] * M) + (term[2] * MDash) + (term[3] * F) + (term[4] * omega))
+		sumLongitude += (term[5] + (term[6] * T)) * math.Sin(argument)
+		sumObliquity += (term[7] + (term[8] * T)) * math.Cos(argument)
+	}
+
+	return sumLongitude * 0.0001 / 3600, sumObliquity * 0.0001 / 3600
+}
+
+func CalculateNutationIAU2000B(julianEphemerisDate float64) (nutationInLongitude, nutationInObliquity float64) {
+	// Nutation in longitude and in obliquity in decimal degrees by the IAU 2000B model, which keeps the 77 largest
+	// luni-solar terms of IAU 2000A and replaces the planetary terms by a constant offset, accurate to 1 mas
+	// between 1995 and 2050
+	t := (julianEphemerisDate - 2451545.0) / 36525.0
+	l := macros.ConvertDegreesToRadiance(math.Mod(485868.249036+(1717915923.2178*t), 1296000) / 3600)
+	lDash := macros.ConvertDegreesToRadiance(math.Mod(1287104.79305+(129596581.0481*t), 1296000) / 3600)
+	F := macros.ConvertDegreesToRadiance(math.Mod(335779.526232+(1739527262.8478*t), 1296000) / 3600)
+	D := macros.ConvertDegreesToRadiance(math.Mod(1072260.70369+(1602961601.2090*t), 1296000) / 3600)
+	omega := macros.ConvertDegreesToRadiance(math.Mod(450160.398036-(6962890.5431*t), 1296000) / 3600)
+
+	// Smallest terms first to limit the rounding error
+	sumLongitude, sumObliquity := 0.0, 0.0
+	for i := len(nutationTermsIAU2000B) - 1; i >= 0; i-- {
+		term := nutationTermsIAU2000B[i]
+		argument := (term[0] * l) + (term[1] * lDash) + (term[2] * F) + (term[3] * D) + (term[4] * omega)
+		sinArgument, cosArgument := math.Sincos(argument)
+		sumLongitude += ((term[5] + (term[6] * t)) * sinArgument) + (term[7] * cosArgument)
+		sumObliquity += ((term[8] + (term[9] * t)) * cosArgument) + (term[10] * sinArgument)
+	}
+
+	nutationInLongitude = ((sumLongitude * 1e-7) - 0.000135) / 3600
+	nutationInObliquity = ((sumObliquity * 1e-7) + 0.000388) / 3600
+	return nutationInLongitude, nutationInObliquity
+}
+
+func CalculateMeanObliquityIAU1980(julianEphemerisDate float64) float64 {
+	// Mean obliquity of the ecliptic in decimal degrees by the IAU 1980 polynomial (Meeus 22.2), accurate to 1"
+	// between the years 1000 and 3000
+	T := (julianEphemerisDate - 2451545.0) / 36525.0
+	return (84381.448 - (46.8150 * T) - (0.00059 * math.Pow(T, 2)) + (0.001813 * math.Pow(T, 3))) / 3600
+}
+
+func CalculateMeanObliquityIAU2006(julianEphemerisDate float64) float64 {
+	// Mean obliquity of the ecliptic of date in decimal degrees by the IAU 2006 precession model
+	_, _, _, meanObliquity := CalculateFukushimaWilliamsAngles(julianEphemerisDate)
+	return meanObliquity
+}
+
+func CalculateTrueObliquity(julianEphemerisDate float64) float64 {
+	// Obliquity of the true ecliptic of date to the true equator in decimal degrees, the IAU 2006 mean obliquity
+	// plus the IAU 2000B nutation in obliquity
+	_, nutationInObliquity := CalculateNutationIAU2000B(julianEphemerisDate)
+	return CalculateMeanObliquityIAU2006(julianEphemerisDate) + nutationInObliquity
+}
+
+func CalculateNutationMatrix(julianEphemerisDate float64) vecmat.Mat3 {
+	// Rotation matrix taking a direction from the mean equator and equinox of date to the true equator and equinox
+	// of date, R1(-(epsilonA + deltaEpsilon)) R3(-deltaPsi) R1(epsilonA)
+	_, _, _, meanObliquity := CalculateFukushimaWilliamsAngles(julianEphemerisDate)
+	nutationInLongitude, nutationInObliquity := CalculateNutationIAU2000B(julianEphemerisDate)
+	return vecmat.CalculateRotationX(meanObliquity).Then(vecmat.CalculateRotationZ(-nutationInLongitude)).Then(vecmat.CalculateRotationX(-(meanObliquity + nutationInObliquity)))
+}
+
+func CalculateEquationOfEquinoxes(julianEphemerisDate float64) float64 {
+	// Difference between apparent and mean sidereal time in decimal degrees, the nutation in right ascension plus
+	// the two largest complementary terms of the IERS Conventions
+	_, _, _, meanObliquity := CalculateFukushimaWilliamsAngles(julianEphemerisDate)
+	nutationInLongitude, _ := CalculateNutationIAU2000B(julianEphemerisDate)
+	t := (julianEphemerisDate - 2451545.0) / 36525.0
+	omega := macros.ConvertDegreesToRadiance(math.Mod(450160.398036-(6962890.5431*t), 1296000) / 3600)
+	complementaryTerms := ((0.00264096 * math.Sin(omega)) + (0.00006352 * math.Sin(2*omega))) / 3600
+
+	return (nutationInLongitude * math.Cos(macros.ConvertDegreesToRadiance(meanObliquity))) + complementaryTerms
+}
@@ -0,0 +1,67 @@
+package coords
+
+import (
+	vecmat "go-astronomy/internal/vecMat"
+	"math"
+)
+
+// EquatorialCoordinates holds a right ascension and declination and the reference frame they are referred to. The
+// equinox, a Julian ephemeris date, is only needed by FrameMeanOfDate.
+type EquatorialCoordinates struct {
+	RADecimalHrs  float64
+	DecDecimalDeg float64
+	Frame         ReferenceFrame
+	Equinox       float64
+}
+
+func CalculatePrecessionAnglesIAU1976(julianEphemerisDate, epochJulianEphemerisDate float64) (zeta, z, theta float64) {
+	// Equatorial precession angles zeta, z and theta in decimal degrees from the mean equator and equinox of one
+	// date to those of another (Lieske et al. 1977, Meeus 21.2 and 21.3)
+	T := (julianEphemerisDate - 2451545.0) / 36525.0
+	t := (epochJulianEphemerisDate - julianEphemerisDate) / 36525.0
+	w := 2306.2181 + (1.39656 * T) - (0.000139 * math.Pow(T, 2))
+	zeta = ((w * t) + ((0.30188 - (0.000344 * T)) * math.Pow(t, 2)) + (0.017998 * math.Pow(t, 3))) / 3600
+	z = ((w * t) + ((1.09468 + (0.000066 * T)) * math.Pow(t, 2)) + (0.018203 * math.Pow(t, 3))) / 3600
+	theta = (((2004.3109 - (0.85330 * T) - (0.000217 * math.Pow(T, 2))) * t) - ((0.42665 + (0.000217 * T)) * math.Pow(t, 2)) - (0.041833 * math.Pow(t, 3))) / 3600
+	return zeta, z, theta
+}
+
+func CalculatePrecessionMatrixIAU1976(julianEphemerisDate, epochJulianEphemerisDate float64) vecmat.Mat3 {
+	// Rotation matrix taking a direction from the mean equator and equinox of one date to those of another,
+	// R3(-z) R2(theta) R3(-zeta)
+	zeta, z, theta := CalculatePrecessionAnglesIAU1976(julianEphemerisDate, epochJulianEphemerisDate)
+	return vecmat.CalculateRotationZ(-zeta).Then(vecmat.CalculateRotationY(theta)).Then(vecmat.CalculateRotationZ(-z))
+}
+
+func CalculateFukushimaWilliamsAngles(julianEphemerisDate float64) (gammaBar, phiBar, psiBar, epsilonA float64) {
+	// Precession angles of the IAU 2006 model in decimal degrees (Capitaine et al. 2003, Hilton et al. 2006),
+	// which include the frame bias between the GCRS and the mean equator and equinox of J2000.0. epsilonA is the
+	// mean obliquity of the ecliptic of date.
+	t := (julianEphemerisDate - 2451545.0) / 36525.0
+	gammaBar = (-0.052928 + (10.556378 * t) + (0.4932044 * math.Pow(t, 2)) - (0.00031238 * math.Pow(t, 3)) - (0.000002788 * math.Pow(t, 4)) + (0.0000000260 * math.Pow(t, 5))) / 3600
+	phiBar = (84381.412819 - (46.811016 * t) + (0.0511268 * math.Pow(t, 2)) + (0.00053289 * math.Pow(t, 3)) - (0.000000440 * math.Pow(t, 4)) - (0.0000000176 * math.Pow(t, 5))) / 3600
+	psiBar = (-0.041775 + (5038.481484 * t) + (1.5584175 * math.Pow(t, 2)) - (0.00018522 * math.Pow(t, 3)) - (0.000026452 * math.Pow(t, 4)) - (0.0000000148 * math.Pow(t, 5))) / 3600
+	epsilonA = (84381.406 - (46.836769 * t) - (0.0001831 * math.Pow(t, 2)) + (0.00200340 * math.Pow(t, 3)) - (0.000000576 * math.Pow(t, 4)) - (0.0000000434 * math.Pow(t, 5))) / 3600
+	return gammaBar, phiBar, psiBar, epsilonA
+}
+
+func CalculateBiasPrecessionMatrixIAU2006(julianEphemerisDate float64) vecmat.Mat3 {
+	// Rotation matrix taking a direction from the GCRS, the frame of ICRS catalogues, to the mean equator and
+	// equinox of a date, R1(-epsilonA) R3(-psiBar) R1(phiBar) R3(gammaBar)
+	gammaBar, phiBar, psiBar, epsilonA := CalculateFukushimaWilliamsAngles(julianEphemerisDate)
+	return vecmat.CalculateRotationZ(gammaBar).Then(vecmat.CalculateRotationX(phiBar)).Then(vecmat.CalculateRotationZ(-psiBar)).Then(vecmat.CalculateRotationX(-epsilonA))
+}
+
+func CalculatePrecessionMatrixIAU2006(julianEphemerisDate, epochJulianEphemerisDate float64) vecmat.Mat3 {
+	// Rotation matrix taking a direction from the mean equator and equinox of one date to those of another by the
+	// IAU 2006 model, the frame bias cancelling out
+	return CalculateBiasPrecessionMatrixIAU2006(julianEphemerisDate).Transpose().Then(CalculateBiasPrecessionMatrixIAU2006(epochJulianEphemerisDate))
+}
+
+func (coordinates EquatorialCoordinates) Precess(julianEphemerisDate, epochJulianEphemerisDate float64) EquatorialCoordinates {
+	// Coordinates referred to the mean equator and equinox of julianEphemerisDate carried to those of
+	// epochJulianEphemerisDate by the IAU 2006 precession, valid at the poles and over many centuries
+	matrix := CalculatePrecessionMatrixIAU2006(julianEphemerisDate, epochJulianEphemerisDate)
+	raDecimalDeg, decDecimalDeg := matrix.ApplyDecimalDeg(coordinates.RADecimalHrs*15, coordinates.DecDecimalDeg)
+	return EquatorialCoordinates{RADecimalHrs: raDecimalDeg / 15, DecDecimalDeg: decDecimalDeg, Frame: FrameMeanOfDate, Equinox: epochJulianEphemerisDate}
+}
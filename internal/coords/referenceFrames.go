@@ -0,0 +1,125 @@
+package coords
+
+import (
+	"errors"
+	datetime "go-astronomy/internal/dateTime"
+	vecmat "go-astronomy/internal/vecMat"
+)
+
+var ErrUnknownFrame = errors.New("unknown reference frame")
+
+// ReferenceFrame names the frame right ascension and declination are referred to
+type ReferenceFrame string
+
+// The International Celestial Reference System, realised by the Hipparcos and Gaia catalogues
+const FrameICRS ReferenceFrame = "ICRS"
+
+// The FK5 system, mean equator and equinox of J2000.0
+const FrameFK5 ReferenceFrame = "FK5"
+
+// The FK4 system, mean equator and equinox of B1950.0 with the E-terms of aberration left in the positions
+const FrameFK4 ReferenceFrame = "FK4"
+
+// The mean equator and equinox of the date held in EquatorialCoordinates.Equinox
+const FrameMeanOfDate ReferenceFrame = "MeanOfDate"
+
+// Arcseconds in a century of motion of one radian, the unit of the FK4 to FK5 rates
+const arcsecondsPerRadianCentury = 100 * 206264.80624709636
+
+// Orientation of the FK5 with respect to the Hipparcos frame, and its spin per Julian year, in decimal degrees
+// (Mignard & Froeschle 2000)
+var fk5ToICRSRotation = vecmat.Vec3{-19.9e-3 / 3600, -9.1e-3 / 3600, 22.9e-3 / 3600}
+var fk5ToICRSSpin = vecmat.Vec3{-0.30e-3 / 3600, 0.60e-3 / 3600, 0.70e-3 / 3600}
+
+// E-terms of aberration in radians and their rate in arcseconds per tropical century
+var fk4ETerms = vecmat.Vec3{-1.62557e-6, -0.31919e-6, -0.13843e-6}
+var fk4ETermsRate = vecmat.Vec3{1.245e-3, -1.580e-3, -0.659e-3}
+
+// FK4 B1950.0 to FK5 J2000.0 matrix for positions and the fictitious proper motion, in radians per Julian century,
+// it brings to a star with no proper motion in the FK5 (Standish 1982, Aoki et al. 1983)
+var fk4ToFK5Matrix = vecmat.Mat3{
+	{0.9999256782, -0.0111820611, -0.0048579477},
+	{0.0111820610, 0.9999374784, -0.0000271765},
+	{0.0048579479, -0.0000271474, 0.9999881997},
+}
+var fk4ToFK5MotionMatrix = vecmat.Mat3{
+	{-0.000551, -0.238565, 0.435739},
+	{0.238514, -0.002667, -0.008541},
+	{-0.435623, 0.012254, 0.002117},
+}
+
+func CalculateFK5ToICRSMatrix(epochJulianEphemerisDate float64) vecmat.Mat3 {
+	// Rotation matrix from FK5 J2000.0 positions observed at an epoch to the ICRS, for stars with no proper motion
+	// in the ICRS. The FK5 frame spins slowly against the ICRS so the epoch matters away from J2000.0.
+	years := datetime.ConvertJulianDateToJulianEpoch(epochJulianEphemerisDate) - 2000.0
+	spin := vecmat.ConvertRotationVectorToMat3(fk5ToICRSSpin.Scale(years))
+	return spin.Then(vecmat.ConvertRotationVectorToMat3(fk5ToICRSRotation))
+}
+
+func convertFK4ToFK5(position vecmat.Vec3, besselianEpoch float64) vecmat.Vec3 {
+	// FK4 B1950.0 direction observed at a Besselian epoch carried to FK5 J2000.0, assuming no proper motion in the
+	// FK5, after taking out the E-terms of aberration
+	eTerms := fk4ETerms.Add(fk4ETermsRate.Scale((besselianEpoch - 1950.0) / arcsecondsPerRadianCentury))
+	position = position.Subtract(eTerms.Subtract(position.Scale(position.Dot(eTerms))))
+
+	centuries := (datetime.ConvertJulianDateToJulianEpoch(datetime.ConvertBesselianEpochToJulianDate(besselianEpoch)) - 2000.0) / arcsecondsPerRadianCentury
+	return fk4ToFK5Matrix.Apply(position).Add(fk4ToFK5MotionMatrix.Apply(position).Scale(centuries)).Normalize()
+}
+
+func convertFK5ToFK4(position vecmat.Vec3, besselianEpoch float64) vecmat.Vec3 {
+	// Inverse of convertFK4ToFK5 by Newton iteration, the transposed position matrix standing in for the inverse
+	// of the derivative
+	fk4 := fk4ToFK5Matrix.Transpose().Apply(position).Normalize()
+	for i := 0; i < 10; i++ {
+		residual := position.Subtract(convertFK4ToFK5(fk4, besselianEpoch))
+		fk4 = fk4.Add(fk4ToFK5Matrix.Transpose().Apply(residual)).Normalize()
+		if residual.Length() < 1e-15 {
+			break
+		}
+	}
+	return fk4
+}
+
+func (coordinates EquatorialCoordinates) convertToICRS(epochJulianEphemerisDate float64) (vecmat.Vec3, error) {
+	position := vecmat.ConvertSphericalDecimalDegToVec3(coordinates.RADecimalHrs*15, coordinates.DecDecimalDeg)
+	switch coordinates.Frame {
+	case FrameICRS:
+		return position, nil
+	case FrameFK5:
+		return CalculateFK5ToICRSMatrix(epochJulianEphemerisDate).Apply(position), nil
+	case FrameFK4:
+		fk5 := convertFK4ToFK5(position, datetime.ConvertJulianDateToBesselianEpoch(epochJulianEphemerisDate))
+		return CalculateFK5ToICRSMatrix(epochJulianEphemerisDate).Apply(fk5), nil
+	case FrameMeanOfDate:
+		return CalculateBiasPrecessionMatrixIAU2006(coordinates.Equinox).Transpose().Apply(position), nil
+	}
+	return vecmat.Vec3{}, ErrUnknownFrame
+}
+
+func (coordinates EquatorialCoordinates) ConvertToFrame(frame ReferenceFrame, equinoxJulianEphemerisDate, epochJulianEphemerisDate float64) (EquatorialCoordinates, error) {
+	// Coordinates carried to another reference frame. The epoch is the date of observation, which matters for the
+	// FK4 and FK5 because their frames rotate slowly against the ICRS. The equinox is only used by FrameMeanOfDate.
+	position, err := coordinates.convertToICRS(epochJulianEphemerisDate)
+	if err != nil {
+		return EquatorialCoordinates{}, err
+	}
+
+	converted := EquatorialCoordinates{Frame: frame}
+	switch frame {
+	case FrameICRS:
+	case FrameFK5:
+		position = CalculateFK5ToICRSMatrix(epochJulianEphemerisDate).Transpose().Apply(position)
+	case FrameFK4:
+		position = CalculateFK5ToICRSMatrix(epochJulianEphemerisDate).Transpose().Apply(position)
+		position = convertFK5ToFK4(position, datetime.ConvertJulianDateToBesselianEpoch(epochJulianEphemerisDate))
+	case FrameMeanOfDate:
+		position = CalculateBiasPrecessionMatrixIAU2006(equinoxJulianEphemerisDate).Apply(position)
+		converted.Equinox = equinoxJulianEphemerisDate
+	default:
+		return EquatorialCoordinates{}, ErrUnknownFrame
+	}
+
+	raDecimalDeg, decDecimalDeg := position.ConvertToSphericalDecimalDeg()
+	converted.RADecimalHrs, converted.DecDecimalDeg = raDecimalDeg/15, decDecimalDeg
+	return converted, nil
+}
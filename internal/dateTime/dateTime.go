@@ -368,3 +368,101 @@ func CalculateGreenwichSiderealTimeUsingLocalSiderealTime(hours, minutes int, se
 
 	return GSTHours, GSTMinutes, GSTSeconds, decimalGST
 }
+
+func ConvertJulianDateToGreenwichSiderealTime(julianDate float64) (GSTHrs, GSTMin int, GSTSec, gst float64) {
+	// Mean sidereal time at Greenwich for any instant (Meeus 12.4), in decimal hours
+	centuriesSinceJ2000 := (julianDate - 2451545.0) / 36525.0
+	gstDeg := 280.46061837 + (360.98564736629 * (julianDate - 2451545.0)) + (0.000387933 * math.Pow(centuriesSinceJ2000, 2)) - (math.Pow(centuriesSinceJ2000, 3) / 38710000.0)
+	gst = math.Mod(gstDeg, 360) / 15
+
+	// Normalize GST to the range [0, 24) hours
+	for gst < 0 {
+		gst += 24
+	}
+	for gst >= 24 {
+		gst -= 24
+	}
+
+	GSTHrs, GSTMin, GSTSec = ConvertDecimalHrsToHrsMinSec(gst)
+
+	return GSTHrs, GSTMin, GSTSec, gst
+}
+
+func CalculateDeltaT(day float64, month int, year int) float64 {
+	// Difference between Terrestrial (Dynamical) Time and Universal Time in seconds.
+	// The polynomial expressions are the ones published by Espenak and Meeus for the NASA eclipse canon.
+	y := float64(year) + (float64(month)-0.5)/12.0
+
+	switch {
+	case y < -500 || y >= 2150:
+		u := (y - 1820) / 100
+		return -20 + (32 * math.Pow(u, 2))
+	case y < 500:
+		u := y / 100
+		return 10583.6 - (1014.41 * u) + (33.78311 * math.Pow(u, 2)) - (5.952053 * math.Pow(u, 3)) - (0.1798452 * math.Pow(u, 4)) + (0.022174192 * math.Pow(u, 5)) + (0.0090316521 * math.Pow(u, 6))
+	case y < 1600:
+		u := (y - 1000) / 100
+		return 1574.2 - (556.01 * u) + (71.23472 * math.Pow(u, 2)) + (0.319781 * math.Pow(u, 3)) - (0.8503463 * math.Pow(u, 4)) - (0.005050998 * math.Pow(u, 5)) + (0.0083572073 * math.Pow(u, 6))
+	case y < 1700:
+		t := y - 1600
+		return 120 - (0.9808 * t) - (0.01532 * math.Pow(t, 2)) + (math.Pow(t, 3) / 7129)
+	case y < 1800:
+		t := y - 1700
+		return 8.83 + (0.1603 * t) - (0.0059285 * math.Pow(t, 2)) + (0.00013336 * math.Pow(t, 3)) - (math.Pow(t, 4) / 1174000)
+	case y < 1860:
+		t := y - 1800
+		return 13.72 - (0.332447 * t) + (0.0068612 * math.Pow(t, 2)) + (0.0041116 * math.Pow(t, 3)) - (0.00037436 * math.Pow(t, 4)) + (0.0000121272 * math.Pow(t, 5)) - (0.0000001699 * math.Pow(t, 6)) + (0.000000000875 * math.Pow(t, 7))
+	case y < 1900:
+		t := y - 1860
+		return 7.62 + (0.5737 * t) - (0.251754 * math.Pow(t, 2)) + (0.01680668 * math.Pow(t, 3)) - (0.0004473624 * math.Pow(t, 4)) + (math.Pow(t, 5) / 233174)
+	case y < 1920:
+		t := y - 1900
+		return -2.79 + (1.494119 * t) - (0.0598939 * math.Pow(t, 2)) + (0.0061966 * math.Pow(t, 3)) - (0.000197 * math.Pow(t, 4))
+	case y < 1941:
+		t := y - 1920
+		return 21.20 + (0.84493 * t) - (0.076100 * math.Pow(t, 2)) + (0.0020936 * math.Pow(t, 3))
+	case y < 1961:
+		t := y - 1950
+		return 29.07 + (0.407 * t) - (math.Pow(t, 2) / 233) + (math.Pow(t, 3) / 2547)
+	case y < 1986:
+		t := y - 1975
+		return 45.45 + (1.067 * t) - (math.Pow(t, 2) / 260) - (math.Pow(t, 3) / 718)
+	case y < 2005:
+		t := y - 2000
+		return 63.86 + (0.3345 * t) - (0.060374 * math.Pow(t, 2)) + (0.0017275 * math.Pow(t, 3)) + (0.000651814 * math.Pow(t, 4)) + (0.00002373599 * math.Pow(t, 5))
+	case y < 2050:
+		t := y - 2000
+		return 62.92 + (0.32217 * t) + (0.005589 * math.Pow(t, 2))
+	default:
+		u := (y - 1820) / 100
+		return -20 + (32 * math.Pow(u, 2)) - (0.5628 * (2150 - y))
+	}
+}
+
+func ConvertUniversalTimeToEphemerisTime(julianDate float64) (julianEphemerisDate float64) {
+	day, month, year := ConvertJulianDateToGreenwichDate(julianDate)
+	return julianDate + (CalculateDeltaT(day, month, year) / 86400)
+}
+
+func ConvertEphemerisTimeToUniversalTime(julianEphemerisDate float64) (julianDate float64) {
+	day, month, year := ConvertJulianDateToGreenwichDate(julianEphemerisDate)
+	return julianEphemerisDate - (CalculateDeltaT(day, month, year) / 86400)
+}
+
+func ConvertJulianDateToJulianEpoch(julianDate float64) float64 {
+	// Julian epoch such as J2000.0, counted in Julian years of 365.25 days
+	return 2000.0 + ((julianDate - 2451545.0) / 365.25)
+}
+
+func ConvertJulianEpochToJulianDate(julianEpoch float64) float64 {
+	return 2451545.0 + ((julianEpoch - 2000.0) * 365.25)
+}
+
+func ConvertJulianDateToBesselianEpoch(julianDate float64) float64 {
+	// Besselian epoch such as B1950.0, counted in tropical years from B1900.0, used by the FK4 and older catalogues
+	return 1900.0 + ((julianDate - 2415020.31352) / 365.242198781)
+}
+
+func ConvertBesselianEpochToJulianDate(besselianEpoch float64) float64 {
+	return 2415020.31352 + ((besselianEpoch - 1900.0) * 365.242198781)
+}
@@ -0,0 +1,168 @@
+package eclipse
+
+import (
+	"go-astronomy/internal/coords"
+	datetime "go-astronomy/internal/dateTime"
+	"go-astronomy/internal/macros"
+	"go-astronomy/internal/moon"
+	"math"
+)
+
+const (
+	PenumbralEclipse = "Penumbral"
+	PartialEclipse   = "Partial"
+	TotalEclipse     = "Total"
+)
+
+// LunarEclipse holds the circumstances of a lunar eclipse. All times are Julian dates in UT,
+// contacts which do not take place for the type of eclipse are left as 0.
+type LunarEclipse struct {
+	EclipseType        string
+	GreatestEclipse    float64
+	P1, U1, U2         float64
+	U3, U4, P4         float64
+	PenumbralMagnitude float64
+	UmbralMagnitude    float64
+	Gamma              float64
+}
+
+func calculateEclipseElements(k float64, isLunar bool) (hasEclipse bool, julianEphemerisDate, gamma, u, MDash float64) {
+	// Shared part of Meeus chapter 54 for the New Moon (solar) or Full Moon (lunar) with lunation number k
+	T := k / 1236.85
+	F := macros.AdjustAngleRange(160.7108+(390.67050284*k)-(0.0016118*math.Pow(T, 2))-(0.00000227*math.Pow(T, 3))+(0.000000011*math.Pow(T, 4)), 0, 360)
+	if math.Abs(math.Sin(macros.ConvertDegreesToRadiance(F))) > 0.36 {
+		return false, 0, 0, 0, 0
+	}
+
+	julianEphemerisDate = 2451550.09766 + (29.530588861 * k) + (0.00015437 * math.Pow(T, 2)) - (0.000000150 * math.Pow(T, 3)) + (0.00000000073 * math.Pow(T, 4))
+	M := macros.ConvertDegreesToRadiance(2.5534 + (29.10535670 * k) - (0.0000014 * math.Pow(T, 2)) - (0.00000011 * math.Pow(T, 3)))
+	MDash = macros.ConvertDegreesToRadiance(201.5643 + (385.81693528 * k) + (0.0107582 * math.Pow(T, 2)) + (0.00001238 * math.Pow(T, 3)) - (0.000000058 * math.Pow(T, 4)))
+	omega := macros.ConvertDegreesToRadiance(124.7746 - (1.56375588 * k) + (0.0020672 * math.Pow(T, 2)) + (0.00000215 * math.Pow(T, 3)))
+	E := 1 - (0.002516 * T) - (0.0000074 * math.Pow(T, 2))
+	F1 := macros.ConvertDegreesToRadiance(F - (0.02665 * math.Sin(omega)))
+	A1 := macros.ConvertDegreesToRadiance(299.77 + (0.107408 * k) - (0.009173 * math.Pow(T, 2)))
+
+	if isLunar {
+		julianEphemerisDate += -(0.4065 * math.Sin(MDash)) + (0.1727 * E * math.Sin(M))
+	} else {
+		julianEphemerisDate += -(0.4075 * math.Sin(MDash)) + (0.1721 * E * math.Sin(M))
+	}
+	julianEphemerisDate += (0.0161 * math.Sin(2*MDash)) - (0.0097 * math.Sin(2*F1)) + (0.0073 * E * math.Sin(MDash-M)) - (0.0050 * E * math.Sin(MDash+M)) -
+		(0.0023 * math.Sin(MDash-(2*F1))) + (0.0021 * E * math.Sin(2*M)) + (0.0012 * math.Sin(MDash+(2*F1))) + (0.0006 * E * math.Sin((2*MDash)+M)) -
+		(0.0004 * math.Sin(3*MDash)) - (0.0003 * E * math.Sin(M+(2*F1))) + (0.0003 * math.Sin(A1)) - (0.0002 * E * math.Sin(M-(2*F1))) -
+		(0.0002 * E * math.Sin((2*MDash)-M)) - (0.0002 * math.Sin(omega))
+
+	P := (0.2070 * E * math.Sin(M)) + (0.0024 * E * math.Sin(2*M)) - (0.0392 * math.Sin(MDash)) + (0.0116 * math.Sin(2*MDash)) - (0.0073 * E * math.Sin(MDash+M)) + (0.0067 * E * math.Sin(MDash-M)) + (0.0118 * math.Sin(2*F1))
+	Q := 5.2207 - (0.0048 * E * math.Cos(M)) + (0.0020 * E * math.Cos(2*M)) - (0.3299 * math.Cos(MDash)) - (0.0060 * E * math.Cos(MDash+M)) + (0.0041 * E * math.Cos(MDash-M))
+	W := math.Abs(math.Cos(F1))
+
+	gamma = ((P * math.Cos(F1)) + (Q * math.Sin(F1))) * (1 - (0.0048 * W))
+	u = 0.0059 + (0.0046 * E * math.Cos(M)) - (0.0182 * math.Cos(MDash)) + (0.0004 * math.Cos(2*MDash)) - (0.0005 * math.Cos(M+MDash))
+
+	return true, julianEphemerisDate, gamma, u, MDash
+}
+
+func calculateLunationNumber(julianDate float64) float64 {
+	// Approximate number of lunations since the New Moon of 2000 January 6
+	return (julianDate - 2451550.09766) / 29.530588861
+}
+
+func CalculateLunarEclipse(k float64) (lunarEclipse LunarEclipse, hasEclipse bool) {
+	// k must be an integer increased by 0.5 (Full Moon), k = 0 corresponds to the New Moon of 2000 January 6
+	hasEclipse, julianEphemerisDate, gamma, u, MDash := calculateEclipseElements(k, true)
+	if !hasEclipse {
+		return lunarEclipse, false
+	}
+
+	penumbralMagnitude := (1.5573 + u - math.Abs(gamma)) / 0.5450
+	umbralMagnitude := (1.0128 - u - math.Abs(gamma)) / 0.5450
+	if penumbralMagnitude <= 0 {
+		return lunarEclipse, false
+	}
+
+	// Semi-durations of the phases in hours
+	p := 1.0128 - u
+	t := 0.4678 - u
+	n := 0.5458 + (0.0400 * math.Cos(MDash))
+	h := 1.5573 + u
+
+	greatestEclipse := datetime.ConvertEphemerisTimeToUniversalTime(julianEphemerisDate)
+	lunarEclipse = LunarEclipse{
+		EclipseType:        PenumbralEclipse,
+		GreatestEclipse:    greatestEclipse,
+		PenumbralMagnitude: penumbralMagnitude,
+		UmbralMagnitude:    umbralMagnitude,
+		Gamma:              gamma,
+	}
+
+	penumbralSemiDuration := math.Sqrt(math.Pow(h, 2)-math.Pow(gamma, 2)) / n
+	lunarEclipse.P1 = greatestEclipse - (penumbralSemiDuration / 24)
+	lunarEclipse.P4 = greatestEclipse + (penumbralSemiDuration / 24)
+
+	if umbralMagnitude > 0 {
+		lunarEclipse.EclipseType = PartialEclipse
+		partialSemiDuration := math.Sqrt(math.Pow(p, 2)-math.Pow(gamma, 2)) / n
+		lunarEclipse.U1 = greatestEclipse - (partialSemiDuration / 24)
+		lunarEclipse.U4 = greatestEclipse + (partialSemiDuration / 24)
+	} else {
+		lunarEclipse.UmbralMagnitude = 0
+	}
+
+	if umbralMagnitude > 1 {
+		lunarEclipse.EclipseType = TotalEclipse
+		totalSemiDuration := math.Sqrt(math.Pow(t, 2)-math.Pow(gamma, 2)) / n
+		lunarEclipse.U2 = greatestEclipse - (totalSemiDuration / 24)
+		lunarEclipse.U3 = greatestEclipse + (totalSemiDuration / 24)
+	}
+
+	return lunarEclipse, true
+}
+
+func CalculateLunarEclipses(startJulianDate, endJulianDate float64) []LunarEclipse {
+	lunarEclipses := []LunarEclipse{}
+	for k := math.Floor(calculateLunationNumber(startJulianDate)) - 0.5; k <= calculateLunationNumber(endJulianDate)+1; k++ {
+		lunarEclipse, hasEclipse := CalculateLunarEclipse(k)
+		if hasEclipse && lunarEclipse.GreatestEclipse >= startJulianDate && lunarEclipse.GreatestEclipse <= endJulianDate {
+			lunarEclipses = append(lunarEclipses, lunarEclipse)
+		}
+	}
+	return lunarEclipses
+}
+
+func calculateTopocentricAltitudeOfMoon(julianDate float64, observer coords.Observer) float64 {
+	julianEphemerisDate := datetime.ConvertUniversalTimeToEphemerisTime(julianDate)
+	_, _, _, _, _, _, raDecimalHrs, decDecimalDeg, distanceKm := moon.CalculateApparentPositionOfMoon(julianEphemerisDate)
+	_, _, _, gst := datetime.ConvertJulianDateToGreenwichSiderealTime(julianDate)
+	hourAngleDeg := (gst * 15) + observer.GeoLong - (raDecimalHrs * 15)
+
+	altitude, _ := coords.ConvertHourAngleDecimalDegToHorizon(hourAngleDeg, decDecimalDeg, observer.GeoLatN)
+	// The Moon is lowered by its parallax when seen from the surface of the Earth
+	return altitude - (moon.CalculateHorizontalParallaxOfMoon(distanceKm) * math.Cos(macros.ConvertDegreesToRadiance(altitude)))
+}
+
+func CalculateLunarEclipseVisibility(lunarEclipse LunarEclipse, observer coords.Observer) (moonAltitudes map[string]float64, isVisible bool) {
+	// Altitude of the Moon's centre at each contact, the eclipse is visible when the Moon is above the
+	// horizon at some instant of the umbral phase (or of the penumbral phase for penumbral eclipses)
+	contacts := map[string]float64{
+		"P1": lunarEclipse.P1, "U1": lunarEclipse.U1, "U2": lunarEclipse.U2, "Greatest": lunarEclipse.GreatestEclipse,
+		"U3": lunarEclipse.U3, "U4": lunarEclipse.U4, "P4": lunarEclipse.P4,
+	}
+	moonAltitudes = map[string]float64{}
+	for contact, julianDate := range contacts {
+		if julianDate != 0 {
+			moonAltitudes[contact] = calculateTopocentricAltitudeOfMoon(julianDate, observer)
+		}
+	}
+
+	start, end := lunarEclipse.P1, lunarEclipse.P4
+	if lunarEclipse.EclipseType != PenumbralEclipse {
+		start, end = lunarEclipse.U1, lunarEclipse.U4
+	}
+	const step = 5.0 / 1440
+	for julianDate := start; julianDate < end+step; julianDate += step {
+		if calculateTopocentricAltitudeOfMoon(math.Min(julianDate, end), observer) > 0 {
+			return moonAltitudes, true
+		}
+	}
+	return moonAltitudes, false
+}
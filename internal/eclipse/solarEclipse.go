@@ -0,0 +1,373 @@
+package eclipse
+
+import (
+	"go-astronomy/internal/coords"
+	datetime "go-astronomy/internal/dateTime"
+	"go-astronomy/internal/macros"
+	"go-astronomy/internal/moon"
+	"go-astronomy/internal/sun"
+	"math"
+)
+
+const (
+	AnnularEclipse = "Annular"
+	HybridEclipse  = "Hybrid"
+)
+
+// Radii used for the shadow cones, in units of the Earth's equatorial radius
+const earthEquatorialRadiusKm = 6378.137
+const astronomicalUnitKm = 149597870.7
+const sunRadius = 109.1222
+const moonPenumbralRadius = 0.272488
+const moonUmbralRadius = 0.272281
+
+// BesselianElements describe the shadow of the Moon on the fundamental plane through the centre of the
+// Earth. Each element is a cubic polynomial in t, the number of hours from T0 (a Julian date in TD). Mu is
+// referred to the ephemeris meridian, so the local hour angle is Mu + longitude - 0.00417807 * DeltaT.
+type BesselianElements struct {
+	T0           float64
+	DeltaT       float64
+	X, Y         [4]float64
+	D, Mu        [4]float64
+	L1, L2       [4]float64
+	TanF1, TanF2 float64
+}
+
+// SolarEclipse holds the global circumstances of a solar eclipse, GreatestEclipse is a Julian date in UT.
+type SolarEclipse struct {
+	EclipseType       string
+	IsCentral         bool
+	GreatestEclipse   float64
+	Gamma             float64
+	Magnitude         float64
+	BesselianElements BesselianElements
+}
+
+// LocalSolarEclipseCircumstances holds the circumstances of a solar eclipse for one place. Contact times are
+// Julian dates in UT, C2 and C3 are left as 0 when the observer is outside the path of the central eclipse.
+type LocalSolarEclipseCircumstances struct {
+	EclipseType    string
+	C1, C2         float64
+	MaximumEclipse float64
+	C3, C4         float64
+	Magnitude      float64
+	Obscuration    float64
+	SunAltitudes   map[string]float64
+}
+
+func calculateBesselianValues(julianEphemerisDate float64) (x, y, d, mu, l1, l2, tanF1, tanF2 float64) {
+	_, _, _, _, _, _, sunRAHrs, sunDecDeg, sunDistanceAU := sun.CalculateApparentPositionOfSun(julianEphemerisDate)
+	_, _, _, _, _, _, moonRAHrs, moonDecDeg, moonDistanceKm := moon.CalculateApparentPositionOfMoon(julianEphemerisDate)
+
+	sunRA := macros.ConvertDegreesToRadiance(coords.ConvertDecimalHrsToDecimalDegress(sunRAHrs))
+	sunDec := macros.ConvertDegreesToRadiance(sunDecDeg)
+	moonRA := macros.ConvertDegreesToRadiance(coords.ConvertDecimalHrsToDecimalDegress(moonRAHrs))
+	moonDec := macros.ConvertDegreesToRadiance(moonDecDeg)
+	sunDistance := sunDistanceAU * astronomicalUnitKm / earthEquatorialRadiusKm
+	moonDistance := moonDistanceKm / earthEquatorialRadiusKm
+
+	// Direction of the shadow axis, from the Moon towards the Sun
+	gx := (sunDistance * math.Cos(sunDec) * math.Cos(sunRA)) - (moonDistance * math.Cos(moonDec) * math.Cos(moonRA))
+	gy := (sunDistance * math.Cos(sunDec) * math.Sin(sunRA)) - (moonDistance * math.Cos(moonDec) * math.Sin(moonRA))
+	gz := (sunDistance * math.Sin(sunDec)) - (moonDistance * math.Sin(moonDec))
+	g := math.Sqrt(math.Pow(gx, 2) + math.Pow(gy, 2) + math.Pow(gz, 2))
+	a := math.Atan2(gy, gx)
+	dRad := math.Asin(gz / g)
+
+	x = moonDistance * math.Cos(moonDec) * math.Sin(moonRA-a)
+	y = moonDistance * ((math.Sin(moonDec) * math.Cos(dRad)) - (math.Cos(moonDec) * math.Sin(dRad) * math.Cos(moonRA-a)))
+	z := moonDistance * ((math.Sin(moonDec) * math.Sin(dRad)) + (math.Cos(moonDec) * math.Cos(dRad) * math.Cos(moonRA-a)))
+
+	d = macros.ConvertRadianceToDegree(dRad)
+	// Apparent sidereal time at Greenwich, taken on the ephemeris meridian as in the published elements
+	mu = macros.AdjustAngleRange(coords.CalculateGreenwichApparentSiderealTime(julianEphemerisDate)-macros.ConvertRadianceToDegree(a), 0, 360)
+
+	sinF1 := (sunRadius + moonPenumbralRadius) / g
+	sinF2 := (sunRadius - moonUmbralRadius) / g
+	tanF1 = sinF1 / math.Sqrt(1-math.Pow(sinF1, 2))
+	tanF2 = sinF2 / math.Sqrt(1-math.Pow(sinF2, 2))
+	l1 = (z * tanF1) + (moonPenumbralRadius / math.Sqrt(1-math.Pow(sinF1, 2)))
+	l2 = (z * tanF2) - (moonUmbralRadius / math.Sqrt(1-math.Pow(sinF2, 2)))
+
+	return x, y, d, mu, l1, l2, tanF1, tanF2
+}
+
+func fitCubicPolynomial(times, values []float64) (coefficients [4]float64) {
+	// Least squares fit of a cubic polynomial by solving the normal equations
+	var matrix [4][5]float64
+	for i := range times {
+		for row := 0; row < 4; row++ {
+			for column := 0; column < 4; column++ {
+				matrix[row][column] += math.Pow(times[i], float64(row+column))
+			}
+			matrix[row][4] += values[i] * math.Pow(times[i], float64(row))
+		}
+	}
+
+	for pivot := 0; pivot < 4; pivot++ {
+		for row := pivot + 1; row < 4; row++ {
+			factor := matrix[row][pivot] / matrix[pivot][pivot]
+			for column := pivot; column < 5; column++ {
+				matrix[row][column] -= factor * matrix[pivot][column]
+			}
+		}
+	}
+	for row := 3; row >= 0; row-- {
+		sum := matrix[row][4]
+		for column := row + 1; column < 4; column++ {
+			sum -= matrix[row][column] * coefficients[column]
+		}
+		coefficients[row] = sum / matrix[row][row]
+	}
+
+	return coefficients
+}
+
+func evaluatePolynomial(coefficients [4]float64, t float64) float64 {
+	return coefficients[0] + (t * (coefficients[1] + (t * (coefficients[2] + (t * coefficients[3])))))
+}
+
+func evaluatePolynomialDerivative(coefficients [4]float64, t float64) float64 {
+	return coefficients[1] + (t * ((2 * coefficients[2]) + (t * 3 * coefficients[3])))
+}
+
+func CalculateBesselianElements(julianEphemerisDate float64) BesselianElements {
+	// The elements are referred to the whole hour of TD nearest to the given instant and fitted over +/- 3 hours
+	T0 := math.Floor(julianEphemerisDate*24+0.5) / 24
+	day, month, year := datetime.ConvertJulianDateToGreenwichDate(T0)
+	deltaT := datetime.CalculateDeltaT(day, month, year)
+
+	times := []float64{-3, -2, -1, 0, 1, 2, 3}
+	xValues, yValues, dValues, muValues, l1Values, l2Values := []float64{}, []float64{}, []float64{}, []float64{}, []float64{}, []float64{}
+	tanF1, tanF2 := 0.0, 0.0
+	for _, t := range times {
+		x, y, d, mu, l1, l2, tF1, tF2 := calculateBesselianValues(T0 + (t / 24))
+		// Keep the Greenwich hour angle increasing steadily across 360 degrees
+		if len(muValues) > 0 {
+			for mu < muValues[len(muValues)-1] {
+				mu += 360
+			}
+		}
+		xValues, yValues, dValues, muValues = append(xValues, x), append(yValues, y), append(dValues, d), append(muValues, mu)
+		l1Values, l2Values = append(l1Values, l1), append(l2Values, l2)
+		if t == 0 {
+			tanF1, tanF2 = tF1, tF2
+		}
+	}
+
+	besselianElements := BesselianElements{
+		T0:     T0,
+		DeltaT: deltaT,
+		X:      fitCubicPolynomial(times, xValues),
+		Y:      fitCubicPolynomial(times, yValues),
+		D:      fitCubicPolynomial(times, dValues),
+		Mu:     fitCubicPolynomial(times, muValues),
+		L1:     fitCubicPolynomial(times, l1Values),
+		L2:     fitCubicPolynomial(times, l2Values),
+		TanF1:  tanF1,
+		TanF2:  tanF2,
+	}
+	besselianElements.Mu[0] = macros.AdjustAngleRange(besselianElements.Mu[0], 0, 360)
+
+	return besselianElements
+}
+
+func CalculateSolarEclipse(k float64) (solarEclipse SolarEclipse, hasEclipse bool) {
+	// k must be an integer (New Moon), k = 0 corresponds to the New Moon of 2000 January 6
+	hasEclipse, julianEphemerisDate, gamma, u, _ := calculateEclipseElements(k, false)
+	if !hasEclipse || math.Abs(gamma) > 1.5433+u+0.01 {
+		return solarEclipse, false
+	}
+
+	besselianElements := CalculateBesselianElements(julianEphemerisDate)
+	B := besselianElements
+
+	// Greatest eclipse is the instant the shadow axis passes closest to the centre of the Earth
+	t := 0.0
+	for i := 0; i < 20; i++ {
+		x, y := evaluatePolynomial(B.X, t), evaluatePolynomial(B.Y, t)
+		dx, dy := evaluatePolynomialDerivative(B.X, t), evaluatePolynomialDerivative(B.Y, t)
+		deltaTime := -((x * dx) + (y * dy)) / (math.Pow(dx, 2) + math.Pow(dy, 2))
+		t += deltaTime
+		if math.Abs(deltaTime) < 1e-7 {
+			break
+		}
+	}
+	x, y := evaluatePolynomial(B.X, t), evaluatePolynomial(B.Y, t)
+	l1, l2 := evaluatePolynomial(B.L1, t), evaluatePolynomial(B.L2, t)
+	gamma = math.Copysign(math.Sqrt(math.Pow(x, 2)+math.Pow(y, 2)), y)
+
+	if math.Abs(gamma) > 0.9972+l1 {
+		return solarEclipse, false
+	}
+
+	solarEclipse = SolarEclipse{
+		GreatestEclipse:   B.T0 + ((t - (B.DeltaT / 3600)) / 24),
+		Gamma:             gamma,
+		BesselianElements: besselianElements,
+		IsCentral:         math.Abs(gamma) < 0.9972,
+	}
+
+	switch {
+	case solarEclipse.IsCentral:
+		zeta := math.Sqrt(1 - math.Pow(gamma, 2))
+		L1, L2 := l1-(zeta*B.TanF1), l2-(zeta*B.TanF2)
+		solarEclipse.Magnitude = (L1 - L2) / (L1 + L2)
+
+		// The umbral cone may fall short of the Earth at the ends of the path but reach it in the middle
+		hasTotalPhase, hasAnnularPhase := false, false
+		for tc := t - 4; tc <= t+4; tc += 1.0 / 60 {
+			xc, yc := evaluatePolynomial(B.X, tc), evaluatePolynomial(B.Y, tc)
+			if math.Pow(xc, 2)+math.Pow(yc, 2) >= math.Pow(0.9972, 2) {
+				continue
+			}
+			if evaluatePolynomial(B.L2, tc)-(math.Sqrt(1-math.Pow(xc, 2)-math.Pow(yc, 2))*B.TanF2) < 0 {
+				hasTotalPhase = true
+			} else {
+				hasAnnularPhase = true
+			}
+		}
+		switch {
+		case hasTotalPhase && hasAnnularPhase:
+			solarEclipse.EclipseType = HybridEclipse
+		case hasTotalPhase:
+			solarEclipse.EclipseType = TotalEclipse
+		default:
+			solarEclipse.EclipseType = AnnularEclipse
+		}
+	case math.Abs(gamma) < 0.9972+math.Abs(l2):
+		// Non-central eclipse, the edge of the umbra or antumbra touches the Earth near the poles
+		solarEclipse.Magnitude = (l1 - l2) / (l1 + l2)
+		if l2 < 0 {
+			solarEclipse.EclipseType = TotalEclipse
+		} else {
+			solarEclipse.EclipseType = AnnularEclipse
+		}
+	default:
+		solarEclipse.EclipseType = PartialEclipse
+		solarEclipse.Magnitude = (l1 - (math.Abs(gamma) - 0.9972)) / (l1 + l2)
+	}
+
+	return solarEclipse, true
+}
+
+func CalculateSolarEclipses(startJulianDate, endJulianDate float64) []SolarEclipse {
+	solarEclipses := []SolarEclipse{}
+	for k := math.Floor(calculateLunationNumber(startJulianDate)) - 1; k <= calculateLunationNumber(endJulianDate)+1; k++ {
+		solarEclipse, hasEclipse := CalculateSolarEclipse(k)
+		if hasEclipse && solarEclipse.GreatestEclipse >= startJulianDate && solarEclipse.GreatestEclipse <= endJulianDate {
+			solarEclipses = append(solarEclipses, solarEclipse)
+		}
+	}
+	return solarEclipses
+}
+
+func calculateLocalShadowGeometry(B BesselianElements, t, pSin, pCos float64, observer coords.Observer) (m, L1, L2, sunAltitude float64) {
+	// Distance of the observer from the shadow axis and the radii of the penumbra and umbra at the observer
+	d := macros.ConvertDegreesToRadiance(evaluatePolynomial(B.D, t))
+	H := macros.ConvertDegreesToRadiance(evaluatePolynomial(B.Mu, t) + observer.GeoLong - (0.00417807 * B.DeltaT))
+
+	xi := pCos * math.Sin(H)
+	eta := (pSin * math.Cos(d)) - (pCos * math.Cos(H) * math.Sin(d))
+	zeta := (pSin * math.Sin(d)) + (pCos * math.Cos(H) * math.Cos(d))
+
+	m = math.Sqrt(math.Pow(evaluatePolynomial(B.X, t)-xi, 2) + math.Pow(evaluatePolynomial(B.Y, t)-eta, 2))
+	L1 = evaluatePolynomial(B.L1, t) - (zeta * B.TanF1)
+	L2 = evaluatePolynomial(B.L2, t) - (zeta * B.TanF2)
+
+	latRad := macros.ConvertDegreesToRadiance(observer.GeoLatN)
+	sunAltitude = macros.ConvertRadianceToDegree(math.Asin((math.Sin(d) * math.Sin(latRad)) + (math.Cos(d) * math.Cos(latRad) * math.Cos(H))))
+
+	return m, L1, L2, sunAltitude
+}
+
+func calculateObscuration(m, L1, L2 float64) float64 {
+	// Fraction of the solar disk covered by the Moon, from the areas of the two overlapping disks
+	sunDiskRadius := (L1 + L2) / 2
+	moonDiskRadius := (L1 - L2) / 2
+	if m >= sunDiskRadius+moonDiskRadius {
+		return 0
+	}
+	if m <= math.Abs(moonDiskRadius-sunDiskRadius) {
+		return math.Min(1, math.Pow(moonDiskRadius/sunDiskRadius, 2))
+	}
+
+	sunAngle := math.Acos((math.Pow(m, 2) + math.Pow(sunDiskRadius, 2) - math.Pow(moonDiskRadius, 2)) / (2 * m * sunDiskRadius))
+	moonAngle := math.Acos((math.Pow(m, 2) + math.Pow(moonDiskRadius, 2) - math.Pow(sunDiskRadius, 2)) / (2 * m * moonDiskRadius))
+	overlap := (math.Pow(sunDiskRadius, 2) * (sunAngle - (math.Sin(2*sunAngle) / 2))) + (math.Pow(moonDiskRadius, 2) * (moonAngle - (math.Sin(2*moonAngle) / 2)))
+
+	return overlap / (math.Pi * math.Pow(sunDiskRadius, 2))
+}
+
+func CalculateLocalSolarEclipseCircumstances(solarEclipse SolarEclipse, observer coords.Observer) (localCircumstances LocalSolarEclipseCircumstances, isVisible bool) {
+	B := solarEclipse.BesselianElements
+	pSin, pCos := coords.CalculateGeocentricParallax(observer.HeightFromSeaLevel, -observer.GeoLong, observer.GeoLatN)
+
+	penumbralContact := func(t float64) float64 {
+		m, L1, _, _ := calculateLocalShadowGeometry(B, t, pSin, pCos, observer)
+		return m - L1
+	}
+	umbralContact := func(t float64) float64 {
+		m, _, L2, _ := calculateLocalShadowGeometry(B, t, pSin, pCos, observer)
+		return m - math.Abs(L2)
+	}
+	findContact := func(contact func(float64) float64, start, end float64) float64 {
+		return macros.FindRoot(contact, start, end, 1e-9)
+	}
+
+	// Find the instant of maximum eclipse by scanning minute by minute and refining with golden section search
+	const step = 1.0 / 60
+	tMaximum, minimumSeparation := 0.0, math.Inf(1)
+	for t := -4.0; t <= 4.0; t += step {
+		if separation := penumbralContact(t); separation < minimumSeparation {
+			tMaximum, minimumSeparation = t, separation
+		}
+	}
+	if minimumSeparation >= 0 {
+		return localCircumstances, false
+	}
+	tMaximum = macros.FindMinimum(func(t float64) float64 {
+		m, _, _, _ := calculateLocalShadowGeometry(B, t, pSin, pCos, observer)
+		return m
+	}, tMaximum-step, tMaximum+step, 1e-9)
+
+	toJulianDate := func(t float64) float64 {
+		return B.T0 + ((t - (B.DeltaT / 3600)) / 24)
+	}
+	tC1 := findContact(penumbralContact, tMaximum-4, tMaximum)
+	tC4 := findContact(penumbralContact, tMaximum, tMaximum+4)
+
+	m, L1, L2, sunAltitude := calculateLocalShadowGeometry(B, tMaximum, pSin, pCos, observer)
+	localCircumstances = LocalSolarEclipseCircumstances{
+		EclipseType:    PartialEclipse,
+		C1:             toJulianDate(tC1),
+		MaximumEclipse: toJulianDate(tMaximum),
+		C4:             toJulianDate(tC4),
+		Magnitude:      (L1 - m) / (L1 + L2),
+		Obscuration:    calculateObscuration(m, L1, L2),
+		SunAltitudes:   map[string]float64{"Maximum": sunAltitude},
+	}
+
+	if m < math.Abs(L2) {
+		if L2 < 0 {
+			localCircumstances.EclipseType = TotalEclipse
+		} else {
+			localCircumstances.EclipseType = AnnularEclipse
+		}
+		tC2 := findContact(umbralContact, tC1, tMaximum)
+		tC3 := findContact(umbralContact, tMaximum, tC4)
+		localCircumstances.C2, localCircumstances.C3 = toJulianDate(tC2), toJulianDate(tC3)
+		_, _, _, localCircumstances.SunAltitudes["C2"] = calculateLocalShadowGeometry(B, tC2, pSin, pCos, observer)
+		_, _, _, localCircumstances.SunAltitudes["C3"] = calculateLocalShadowGeometry(B, tC3, pSin, pCos, observer)
+	}
+	_, _, _, localCircumstances.SunAltitudes["C1"] = calculateLocalShadowGeometry(B, tC1, pSin, pCos, observer)
+	_, _, _, localCircumstances.SunAltitudes["C4"] = calculateLocalShadowGeometry(B, tC4, pSin, pCos, observer)
+
+	// The eclipse can only be seen if the Sun is above the horizon at some point between the contacts
+	for t := tC1; t <= tC4+step; t += step {
+		if _, _, _, altitude := calculateLocalShadowGeometry(B, math.Min(t, tC4), pSin, pCos, observer); altitude > 0 {
+			return localCircumstances, true
+		}
+	}
+	return localCircumstances, false
+}
@@ -0,0 +1,480 @@
+package jupitermoons
+
+import (
+	datetime "go-astronomy/internal/dateTime"
+	"go-astronomy/internal/macros"
+	"go-astronomy/internal/planets"
+	vecmat "go-astronomy/internal/vecMat"
+	"math"
+	"sort"
+)
+
+const (
+	Transit       = "Transit"
+	ShadowTransit = "Shadow Transit"
+	Occultation   = "Occultation"
+	Eclipse       = "Eclipse"
+)
+
+// Ratio of the equatorial to the polar diameter of Jupiter, used to stretch Y onto a circular disk
+const jupiterEllipticity = 1.071374
+
+var SatelliteNames = [4]string{"Io", "Europa", "Ganymede", "Callisto"}
+
+// Light speed in units of Jupiter's equatorial radius per satellite period, for the differential light-time
+var differentialLightTime = [4]float64{17295, 21819, 27558, 36548}
+
+// SatellitePosition holds the apparent rectangular coordinates of a satellite referred to the centre of Jupiter
+// in units of Jupiter's equatorial radius. X is positive to the west, Y positive to the north and Z is positive
+// when the satellite is farther from the observer than Jupiter.
+type SatellitePosition struct {
+	X, Y, Z float64
+}
+
+// SatelliteEvent holds one phenomenon of a satellite, Start and End are Julian dates in UT at which the centre
+// of the satellite (or of its shadow) crosses the limb of Jupiter.
+type SatelliteEvent struct {
+	Satellite string
+	EventType string
+	Start     float64
+	End       float64
+}
+
+func CalculateApproximatePositionsOfSatellites(julianEphemerisDate float64) (positions [4]SatellitePosition) {
+	// Low accuracy positions of Io, Europa, Ganymede and Callisto (Meeus chapter 44), good to about 0.1 radius
+	d := julianEphemerisDate - 2451545.0
+	V := 172.74 + (0.00111588 * d)
+	M := 357.529 + (0.9856003 * d)
+	N := 20.020 + (0.0830853 * d) + (0.329 * macros.SinDeg(V))
+	J := 66.115 + (0.9025179 * d) - (0.329 * macros.SinDeg(V))
+	A := (1.915 * macros.SinDeg(M)) + (0.020 * macros.SinDeg(2*M))
+	B := (5.555 * macros.SinDeg(N)) + (0.168 * macros.SinDeg(2*N))
+	K := J + A - B
+	R := 1.00014 - (0.01671 * macros.CosDeg(M)) - (0.00014 * macros.CosDeg(2*M))
+	r := 5.20872 - (0.25208 * macros.CosDeg(N)) - (0.00611 * macros.CosDeg(2*N))
+	delta := math.Sqrt(math.Pow(r, 2) + math.Pow(R, 2) - (2 * r * R * macros.CosDeg(K)))
+	psi := macros.ConvertRadianceToDegree(math.Asin(R / delta * macros.SinDeg(K)))
+	lambda := 34.35 + (0.083091 * d) + (0.329 * macros.SinDeg(V)) + B
+	DS := 3.12 * macros.SinDeg(lambda+42.8)
+	DE := DS - (2.22 * macros.SinDeg(psi) * macros.CosDeg(lambda+22)) - (1.30 * (r - delta) / delta * macros.SinDeg(lambda-100.5))
+
+	// Arguments of the satellites measured from the inferior conjunction, corrected for light-time
+	dd := d - (delta / 173)
+	u := [4]float64{
+		163.8069 + (203.4058646 * dd) + psi - B,
+		358.4140 + (101.2916335 * dd) + psi - B,
+		5.7176 + (50.2345180 * dd) + psi - B,
+		224.8092 + (21.4879800 * dd) + psi - B,
+	}
+	G := 331.18 + (50.310482 * dd)
+	H := 87.45 + (21.569231 * dd)
+	u[0] += 0.473 * macros.SinDeg(2*(u[0]-u[1]))
+	u[1] += 1.065 * macros.SinDeg(2*(u[1]-u[2]))
+	u[2] += 0.165 * macros.SinDeg(G)
+	u[3] += 0.843 * macros.SinDeg(H)
+	radii := [4]float64{
+		5.9057 - (0.0244 * macros.CosDeg(2*(u[0]-u[1]))),
+		9.3966 - (0.0882 * macros.CosDeg(2*(u[1]-u[2]))),
+		14.9883 - (0.0216 * macros.CosDeg(G)),
+		26.3627 - (0.1939 * macros.CosDeg(H)),
+	}
+
+	for i := range positions {
+		positions[i] = SatellitePosition{
+			X: radii[i] * macros.SinDeg(u[i]),
+			Y: -radii[i] * macros.CosDeg(u[i]) * macros.SinDeg(DE),
+			Z: -radii[i] * macros.CosDeg(u[i]),
+		}
+	}
+	return positions
+}
+
+func calculateSatellitePositions(julianEphemerisDate float64, isSeenFromSun bool) (positions [4]SatellitePosition) {
+	// Positions from the theory E5 (Meeus chapter 44, high accuracy method). Seen from the Sun the satellites
+	// are placed where their shadows fall, at the instant the phenomenon is seen from the Earth.
+	x, y, z, delta, lightTime, l, b, r := planets.CalculateGeometricPositionOfPlanet(julianEphemerisDate, "Jupiter")
+	lambda0, beta0 := vecmat.Vec3{x, y, z}.ConvertToSphericalDecimalDeg()
+	if isSeenFromSun {
+		lambda0, beta0, delta = l, b, r
+	}
+	t := julianEphemerisDate - 2443000.5 - lightTime
+
+	// Mean longitudes, longitudes of the perijoves and of the nodes of the satellites
+	l1 := 106.07719 + (203.488955790 * t)
+	l2 := 175.73161 + (101.374724735 * t)
+	l3 := 120.55883 + (50.317609207 * t)
+	l4 := 84.44459 + (21.571071177 * t)
+	pi1 := 97.0881 + (0.16138586 * t)
+	pi2 := 154.8663 + (0.04726307 * t)
+	pi3 := 188.1840 + (0.00712734 * t)
+	pi4 := 335.2868 + (0.00184000 * t)
+	omega1 := 312.3346 - (0.13279386 * t)
+	omega2 := 100.4411 - (0.03263064 * t)
+	omega3 := 119.1942 - (0.00717703 * t)
+	omega4 := 322.6186 - (0.00175934 * t)
+	gamma := (0.33033 * macros.SinDeg(163.679+(0.0010512*t))) + (0.03439 * macros.SinDeg(34.486-(0.0161731*t)))
+	phiLambda := 199.6766 + (0.17379190 * t)
+	psi := 316.5182 - (0.00000208 * t)
+	G := 30.23756 + (0.0830925701 * t) + gamma
+	GDash := 31.97853 + (0.0334597339 * t)
+	PI := 13.469942
+
+	// Periodic terms in the longitudes of the satellites
+	sigma1 := 0.47259*macros.SinDeg(2*(l1-l2)) -
+		0.03478*macros.SinDeg(pi3-pi4) +
+		0.01081*macros.SinDeg(l2-2*l3+pi3) +
+		0.00738*macros.SinDeg(phiLambda) +
+		0.00713*macros.SinDeg(l2-2*l3+pi2) -
+		0.00674*macros.SinDeg(pi1+pi3-2*PI-2*G) +
+		0.00666*macros.SinDeg(l2-2*l3+pi4) +
+		0.00445*macros.SinDeg(l1-pi3) -
+		0.00354*macros.SinDeg(l1-l2) -
+		0.00317*macros.SinDeg(2*psi-2*PI) +
+		0.00265*macros.SinDeg(l1-pi4) -
+		0.00186*macros.SinDeg(G) +
+		0.00162*macros.SinDeg(pi2-pi3) +
+		0.00158*macros.SinDeg(4*(l1-l2)) -
+		0.00155*macros.SinDeg(l1-l3) -
+		0.00138*macros.SinDeg(psi+omega3-2*PI-2*G) -
+		0.00115*macros.SinDeg(2*(l1-2*l2+omega2)) +
+		0.00089*macros.SinDeg(pi2-pi4) +
+		0.00085*macros.SinDeg(l1+pi3-2*PI-2*G) +
+		0.00083*macros.SinDeg(omega2-omega3) +
+		0.00053*macros.SinDeg(psi-omega2)
+	sigma2 := 1.06476*macros.SinDeg(2*(l2-l3)) +
+		0.04256*macros.SinDeg(l1-2*l2+pi3) +
+		0.03581*macros.SinDeg(l2-pi3) +
+		0.02395*macros.SinDeg(l1-2*l2+pi4) +
+		0.01984*macros.SinDeg(l2-pi4) -
+		0.01778*macros.SinDeg(phiLambda) +
+		0.01654*macros.SinDeg(l2-pi2) +
+		0.01334*macros.SinDeg(l2-2*l3+pi2) +
+		0.01294*macros.SinDeg(pi3-pi4) -
+		0.01142*macros.SinDeg(l2-l3) -
+		0.01057*macros.SinDeg(G) -
+		0.00775*macros.SinDeg(2*(psi-PI)) +
+		0.00524*macros.SinDeg(2*(l1-l2)) -
+		0.0046*macros.SinDeg(l1-l3) +
+		0.00316*macros.SinDeg(psi-2*G+omega3-2*PI) -
+		0.00203*macros.SinDeg(pi1+pi3-2*PI-2*G) +
+		0.00146*macros.SinDeg(psi-omega3) -
+		0.00145*macros.SinDeg(2*G) +
+		0.00125*macros.SinDeg(psi-omega4) -
+		0.00115*macros.SinDeg(l1-2*l3+pi3) -
+		0.00094*macros.SinDeg(2*(l2-omega2)) +
+		0.00086*macros.SinDeg(2*(l1-2*l2+omega2)) -
+		0.00086*macros.SinDeg(5*GDash-2*G+52.225) -
+		0.00078*macros.SinDeg(l2-l4) -
+		0.00064*macros.SinDeg(3*l3-7*l4+4*pi4) +
+		0.00064*macros.SinDeg(pi1-pi4) -
+		0.00063*macros.SinDeg(l1-2*l3+pi4) +
+		0.00058*macros.SinDeg(omega3-omega4) +
+		0.00056*macros.SinDeg(2*(psi-PI-G)) +
+		0.00056*macros.SinDeg(2*(l2-l4)) +
+		0.00055*macros.SinDeg(2*(l1-l3)) +
+		0.00052*macros.SinDeg(3*l3-7*l4+pi3+3*pi4) -
+		0.00043*macros.SinDeg(l1-pi3) +
+		0.00041*macros.SinDeg(5*(l2-l3)) +
+		0.00041*macros.SinDeg(pi4-PI) +
+		0.00032*macros.SinDeg(omega2-omega3) +
+		0.00032*macros.SinDeg(2*(l3-G-PI))
+	sigma3 := 0.1649*macros.SinDeg(l3-pi3) +
+		0.09081*macros.SinDeg(l3-pi4) -
+		0.06907*macros.SinDeg(l2-l3) +
+		0.03784*macros.SinDeg(pi3-pi4) +
+		0.01846*macros.SinDeg(2*(l3-l4)) -
+		0.0134*macros.SinDeg(G) -
+		0.01014*macros.SinDeg(2*(psi-PI)) +
+		0.00704*macros.SinDeg(l2-2*l3+pi3) -
+		0.0062*macros.SinDeg(l2-2*l3+pi2) -
+		0.00541*macros.SinDeg(l3-l4) +
+		0.00381*macros.SinDeg(l2-2*l3+pi4) +
+		0.00235*macros.SinDeg(psi-omega3) +
+		0.00198*macros.SinDeg(psi-omega4) +
+		0.00176*macros.SinDeg(phiLambda) +
+		0.0013*macros.SinDeg(3*(l3-l4)) +
+		0.00125*macros.SinDeg(l1-l3) -
+		0.00119*macros.SinDeg(5*GDash-2*G+52.225) +
+		0.00109*macros.SinDeg(l1-l2) -
+		0.001*macros.SinDeg(3*l3-7*l4+4*pi4) +
+		0.00091*macros.SinDeg(omega3-omega4) +
+		0.0008*macros.SinDeg(3*l3-7*l4+pi3+3*pi4) -
+		0.00075*macros.SinDeg(2*l2-3*l3+pi3) +
+		0.00072*macros.SinDeg(pi1+pi3-2*PI-2*G) +
+		0.00069*macros.SinDeg(pi4-PI) -
+		0.00058*macros.SinDeg(2*l3-3*l4+pi4) -
+		0.00057*macros.SinDeg(l3-2*l4+pi4) +
+		0.00056*macros.SinDeg(l3+pi3-2*PI-2*G) -
+		0.00052*macros.SinDeg(l2-2*l3+pi1) -
+		0.00050*macros.SinDeg(pi2-pi3) +
+		0.00048*macros.SinDeg(l3-2*l4+pi3) -
+		0.00045*macros.SinDeg(2*l2-3*l3+pi4) -
+		0.00041*macros.SinDeg(pi2-pi4) -
+		0.00038*macros.SinDeg(2*G) -
+		0.00037*macros.SinDeg(pi3-pi4+omega3-omega4) -
+		0.00032*macros.SinDeg(3*l3-7*l4+2*pi3+2*pi4) +
+		0.0003*macros.SinDeg(4*(l3-l4)) +
+		0.00029*macros.SinDeg(l3+pi4-2*PI-2*G) -
+		0.00028*macros.SinDeg(omega3+psi-2*PI-2*G) +
+		0.00026*macros.SinDeg(l3-PI-G) +
+		0.00024*macros.SinDeg(l2-3*l3+2*l4) +
+		0.00021*macros.SinDeg(2*(l3-PI-G)) -
+		0.00021*macros.SinDeg(l3-pi2) +
+		0.00017*macros.SinDeg(2*(l3-pi3))
+	sigma4 := 0.84287*macros.SinDeg(l4-pi4) +
+		0.03431*macros.SinDeg(pi4-pi3) -
+		0.03305*macros.SinDeg(2*(psi-PI)) -
+		0.03211*macros.SinDeg(G) -
+		0.01862*macros.SinDeg(l4-pi3) +
+		0.01186*macros.SinDeg(psi-omega4) +
+		0.00623*macros.SinDeg(l4+pi4-2*G-2*PI) +
+		0.00387*macros.SinDeg(2*(l4-pi4)) -
+		0.00284*macros.SinDeg(5*GDash-2*G+52.225) -
+		0.00234*macros.SinDeg(2*(psi-pi4)) -
+		0.00223*macros.SinDeg(l3-l4) -
+		0.00208*macros.SinDeg(l4-PI) +
+		0.00178*macros.SinDeg(psi+omega4-2*pi4) +
+		0.00134*macros.SinDeg(pi4-PI) +
+		0.00125*macros.SinDeg(2*(l4-G-PI)) -
+		0.00117*macros.SinDeg(2*G) -
+		0.00112*macros.SinDeg(2*(l3-l4)) +
+		0.00107*macros.SinDeg(3*l3-7*l4+4*pi4) +
+		0.00102*macros.SinDeg(l4-G-PI) +
+		0.00096*macros.SinDeg(2*l4-psi-omega4) +
+		0.00087*macros.SinDeg(2*(psi-omega4)) -
+		0.00085*macros.SinDeg(3*l3-7*l4+pi3+3*pi4) +
+		0.00085*macros.SinDeg(l3-2*l4+pi4) -
+		0.00081*macros.SinDeg(2*(l4-psi)) +
+		0.00071*macros.SinDeg(l4+pi4-2*PI-3*G) +
+		0.00061*macros.SinDeg(l1-l4) -
+		0.00056*macros.SinDeg(psi-omega3) -
+		0.00054*macros.SinDeg(l3-2*l4+pi3) +
+		0.00051*macros.SinDeg(l2-l4) +
+		0.00042*macros.SinDeg(2*(psi-G-PI)) +
+		0.00039*macros.SinDeg(2*(pi4-omega4)) +
+		0.00036*macros.SinDeg(psi+PI-pi4-omega4) +
+		0.00035*macros.SinDeg(2*GDash-G+188.37) -
+		0.00035*macros.SinDeg(l4-pi4+2*PI-2*psi) -
+		0.00032*macros.SinDeg(l4+pi4-2*PI-G) +
+		0.0003*macros.SinDeg(2*GDash-2*G+149.15) +
+		0.00029*macros.SinDeg(3*l3-7*l4+2*pi3+2*pi4) +
+		0.00028*macros.SinDeg(l4-pi4+2*psi-2*PI) -
+		0.00028*macros.SinDeg(2*(l4-omega4)) -
+		0.00027*macros.SinDeg(pi3-pi4+omega3-omega4) -
+		0.00026*macros.SinDeg(5*GDash-3*G+188.37) +
+		0.00025*macros.SinDeg(omega4-omega3) -
+		0.00025*macros.SinDeg(l2-3*l3+2*l4) -
+		0.00023*macros.SinDeg(3*(l3-l4)) +
+		0.00021*macros.SinDeg(2*l4-2*PI-3*G) -
+		0.00021*macros.SinDeg(2*l3-3*l4+pi4) +
+		0.00019*macros.SinDeg(l4-pi4-G) -
+		0.00019*macros.SinDeg(2*l4-pi3-pi4) -
+		0.00018*macros.SinDeg(l4-pi4+G) -
+		0.00016*macros.SinDeg(l4+pi3-2*PI-2*G)
+	L1, L2, L3, L4 := l1+sigma1, l2+sigma2, l3+sigma3, l4+sigma4
+	L := [4]float64{L1, L2, L3, L4}
+
+	// Latitudes in radians referred to the equatorial plane of Jupiter, and radius vectors
+	B := [4]float64{
+		math.Atan(0.0006393*macros.SinDeg(L1-omega1) +
+			0.0001825*macros.SinDeg(L1-omega2) +
+			0.0000329*macros.SinDeg(L1-omega3) -
+			0.0000311*macros.SinDeg(L1-psi) +
+			0.0000093*macros.SinDeg(L1-omega4) +
+			0.0000075*macros.SinDeg(3*L1-4*l2-1.9927*sigma1+omega2) +
+			0.0000046*macros.SinDeg(L1+psi-2*PI-2*G)),
+		math.Atan(0.0081004*macros.SinDeg(L2-omega2) +
+			0.0004512*macros.SinDeg(L2-omega3) -
+			0.0003284*macros.SinDeg(L2-psi) +
+			0.0001160*macros.SinDeg(L2-omega4) +
+			0.0000272*macros.SinDeg(l1-2*l3+1.0146*sigma2+omega2) -
+			0.0000144*macros.SinDeg(L2-omega1) +
+			0.0000143*macros.SinDeg(L2+psi-2*PI-2*G) +
+			0.0000035*macros.SinDeg(L2-psi+G) -
+			0.0000028*macros.SinDeg(l1-2*l3+1.0146*sigma2+omega3)),
+		math.Atan(0.0032402*macros.SinDeg(L3-omega3) -
+			0.0016911*macros.SinDeg(L3-psi) +
+			0.0006847*macros.SinDeg(L3-omega4) -
+			0.0002797*macros.SinDeg(L3-omega2) +
+			0.0000321*macros.SinDeg(L3+psi-2*PI-2*G) +
+			0.0000051*macros.SinDeg(L3-psi+G) -
+			0.0000045*macros.SinDeg(L3-psi-G) -
+			0.0000045*macros.SinDeg(L3+psi-2*PI) +
+			0.0000037*macros.SinDeg(L3+psi-2*PI-3*G) +
+			0.000003*macros.SinDeg(2*l2-3*L3+4.03*sigma3+omega2) -
+			0.0000021*macros.SinDeg(2*l2-3*L3+4.03*sigma3+omega3)),
+		math.Atan(-0.0076579*macros.SinDeg(L4-psi) +
+			0.0044134*macros.SinDeg(L4-omega4) -
+			0.0005112*macros.SinDeg(L4-omega3) +
+			0.0000773*macros.SinDeg(L4+psi-2*PI-2*G) +
+			0.0000104*macros.SinDeg(L4-psi+G) -
+			0.0000102*macros.SinDeg(L4-psi-G) +
+			0.0000088*macros.SinDeg(L4+psi-2*PI-3*G) -
+			0.0000038*macros.SinDeg(L4+psi-2*PI-G)),
+	}
+	R := [4]float64{
+		5.90569 * (1 -
+			0.0041339*macros.CosDeg(2*(l1-l2)) -
+			0.0000387*macros.CosDeg(l1-pi3) -
+			0.0000214*macros.CosDeg(l1-pi4) +
+			0.000017*macros.CosDeg(l1-l2) -
+			0.0000131*macros.CosDeg(4*(l1-l2)) +
+			0.0000106*macros.CosDeg(l1-l3) -
+			0.0000066*macros.CosDeg(l1+pi3-2*PI-2*G)),
+		9.39657 * (1 +
+			0.0093848*macros.CosDeg(l1-l2) -
+			0.0003116*macros.CosDeg(l2-pi3) -
+			0.0001744*macros.CosDeg(l2-pi4) -
+			0.0001442*macros.CosDeg(l2-pi2) +
+			0.0000553*macros.CosDeg(l2-l3) +
+			0.0000523*macros.CosDeg(l1-l3) -
+			0.0000290*macros.CosDeg(2*(l1-l2)) +
+			0.0000164*macros.CosDeg(2*(l2-omega2)) +
+			0.0000107*macros.CosDeg(l1-2*l3+pi3) -
+			0.0000102*macros.CosDeg(l2-pi1) -
+			0.0000091*macros.CosDeg(2*(l1-l3))),
+		14.98832 * (1 -
+			0.0014388*macros.CosDeg(l3-pi3) -
+			0.0007917*macros.CosDeg(l3-pi4) +
+			0.0006342*macros.CosDeg(l2-l3) -
+			0.0001761*macros.CosDeg(2*(l3-l4)) +
+			0.0000294*macros.CosDeg(l3-l4) -
+			0.0000156*macros.CosDeg(3*(l3-l4)) +
+			0.0000156*macros.CosDeg(l1-l3) -
+			0.0000153*macros.CosDeg(l1-l2) +
+			0.000007*macros.CosDeg(2*l2-3*l3+pi3) -
+			0.0000051*macros.CosDeg(l3+pi3-2*PI-2*G)),
+		26.36273 * (1 -
+			0.0073546*macros.CosDeg(l4-pi4) +
+			0.0001621*macros.CosDeg(l4-pi3) +
+			0.0000974*macros.CosDeg(l3-l4) -
+			0.0000543*macros.CosDeg(l4+pi4-2*PI-2*G) -
+			0.0000271*macros.CosDeg(2*(l4-pi4)) +
+			0.0000182*macros.CosDeg(l4-PI) +
+			0.0000177*macros.CosDeg(2*(l3-l4)) -
+			0.0000167*macros.CosDeg(2*l4-psi-omega4) +
+			0.0000167*macros.CosDeg(psi-omega4) -
+			0.0000155*macros.CosDeg(2*(l4-PI-G)) +
+			0.0000142*macros.CosDeg(2*(l4-psi)) +
+			0.0000105*macros.CosDeg(l1-l4) +
+			0.0000092*macros.CosDeg(l2-l4) -
+			0.0000089*macros.CosDeg(l4-PI-G) -
+			0.0000062*macros.CosDeg(l4+pi4-2*PI-3*G) +
+			0.0000048*macros.CosDeg(2*(l4-omega4))),
+	}
+
+	// Precession from the epoch B1950.0 and the inclination of Jupiter's equator on its orbit
+	T0 := (julianEphemerisDate - 2433282.423) / 36525.0
+	P := (1.3966626 + (0.0003088 * T0)) * T0
+	psi += P
+	T := (julianEphemerisDate - 2415020.0) / 36525.0
+	I := 3.120262 + (0.0006 * T)
+
+	// Rectangular coordinates, the fifth point lies on Jupiter's polar axis and gives its position angle
+	X, Y, Z := [5]float64{}, [5]float64{}, [5]float64{}
+	for i := range L {
+		X[i] = R[i] * macros.CosDeg(L[i]+P-psi) * math.Cos(B[i])
+		Y[i] = R[i] * macros.SinDeg(L[i]+P-psi) * math.Cos(B[i])
+		Z[i] = R[i] * math.Sin(B[i])
+	}
+	Z[4] = 1
+
+	elements := planets.OrbitalElements["Jupiter"]
+	T2000 := (julianEphemerisDate - 2451545.0) / 36525.0
+	inclination := elements[3][0] + (T2000 * (elements[3][1] + (T2000 * (elements[3][2] + (T2000 * elements[3][3])))))
+	node := elements[4][0] + (T2000 * (elements[4][1] + (T2000 * (elements[4][2] + (T2000 * elements[4][3])))))
+	A, Bz, C := [5]float64{}, [5]float64{}, [5]float64{}
+	for i := range X {
+		// Rotate to Jupiter's orbit, then to the ecliptic and finally towards the observer
+		a := X[i]
+		b := (Y[i] * macros.CosDeg(I)) - (Z[i] * macros.SinDeg(I))
+		c := (Y[i] * macros.SinDeg(I)) + (Z[i] * macros.CosDeg(I))
+		a, b = (a*macros.CosDeg(psi-node))-(b*macros.SinDeg(psi-node)), (a*macros.SinDeg(psi-node))+(b*macros.CosDeg(psi-node))
+		b, c = (b*macros.CosDeg(inclination))-(c*macros.SinDeg(inclination)), (b*macros.SinDeg(inclination))+(c*macros.CosDeg(inclination))
+		a, b = (a*macros.CosDeg(node))-(b*macros.SinDeg(node)), (a*macros.SinDeg(node))+(b*macros.CosDeg(node))
+		a, b = (a*macros.SinDeg(lambda0))-(b*macros.CosDeg(lambda0)), (a*macros.CosDeg(lambda0))+(b*macros.SinDeg(lambda0))
+		A[i] = a
+		Bz[i] = (c * macros.SinDeg(beta0)) + (b * macros.CosDeg(beta0))
+		C[i] = (c * macros.CosDeg(beta0)) - (b * macros.SinDeg(beta0))
+	}
+
+	D := math.Atan2(A[4], C[4])
+	for i := range positions {
+		x := (A[i] * math.Cos(D)) - (C[i] * math.Sin(D))
+		y := (A[i] * math.Sin(D)) + (C[i] * math.Cos(D))
+		z := Bz[i]
+		// Differential light-time and the effect of perspective
+		x += math.Abs(z) / differentialLightTime[i] * math.Sqrt(1-math.Pow(x/R[i], 2))
+		W := delta / (delta + (z / 2095))
+		positions[i] = SatellitePosition{X: x * W, Y: y * W, Z: z}
+	}
+	return positions
+}
+
+func CalculatePositionsOfSatellites(julianEphemerisDate float64) [4]SatellitePosition {
+	// Apparent positions of the Galilean satellites as seen from the Earth, good to about a thousandth of a radius
+	return calculateSatellitePositions(julianEphemerisDate, false)
+}
+
+func CalculatePositionsOfShadows(julianEphemerisDate float64) [4]SatellitePosition {
+	// Positions of the satellites as seen from the Sun, which is where their shadows fall on the disk of Jupiter
+	return calculateSatellitePositions(julianEphemerisDate, true)
+}
+
+func isOnDisk(position SatellitePosition) bool {
+	return math.Pow(position.X, 2)+math.Pow(position.Y*jupiterEllipticity, 2) < 1
+}
+
+func calculateEventStates(julianDate float64) (states [4]map[string]bool) {
+	julianEphemerisDate := datetime.ConvertUniversalTimeToEphemerisTime(julianDate)
+	positions := CalculatePositionsOfSatellites(julianEphemerisDate)
+	shadows := CalculatePositionsOfShadows(julianEphemerisDate)
+	for i := range states {
+		states[i] = map[string]bool{
+			Transit:       isOnDisk(positions[i]) && positions[i].Z < 0,
+			Occultation:   isOnDisk(positions[i]) && positions[i].Z > 0,
+			ShadowTransit: isOnDisk(shadows[i]) && shadows[i].Z < 0,
+			Eclipse:       isOnDisk(shadows[i]) && shadows[i].Z > 0,
+		}
+	}
+	return states
+}
+
+func CalculateSatelliteEvents(startJulianDate, endJulianDate float64) []SatelliteEvent {
+	// Transits, shadow transits, occultations and eclipses of the Galilean satellites beginning between two Julian
+	// dates in UT. Eclipses use a cylindrical shadow, so they may also be hidden behind the disk of Jupiter.
+	const step = 5.0 / 1440
+	findChange := func(satellite int, eventType string, start, end float64) float64 {
+		return macros.FindTransition(func(julianDate float64) bool {
+			return calculateEventStates(julianDate)[satellite][eventType]
+		}, start, end, 1e-8)
+	}
+
+	// Scan from half a day early so that events in progress at the start are not taken as beginning there
+	events := []SatelliteEvent{}
+	starts := [4]map[string]float64{{}, {}, {}, {}}
+	previous := calculateEventStates(startJulianDate - 0.5)
+	for julianDate := startJulianDate - 0.5 + step; julianDate <= endJulianDate+0.5; julianDate += step {
+		states := calculateEventStates(julianDate)
+		for i := range states {
+			for eventType, isInProgress := range states[i] {
+				if isInProgress == previous[i][eventType] {
+					continue
+				}
+				change := findChange(i, eventType, julianDate-step, julianDate)
+				if isInProgress {
+					starts[i][eventType] = change
+				} else if start, hasStart := starts[i][eventType]; hasStart {
+					if start >= startJulianDate && start <= endJulianDate {
+						events = append(events, SatelliteEvent{Satellite: SatelliteNames[i], EventType: eventType, Start: start, End: change})
+					}
+					delete(starts[i], eventType)
+				}
+			}
+		}
+		previous = states
+	}
+
+	sort.Slice(events, func(i, j int) bool { return events[i].Start < events[j].Start })
+	return events
+}
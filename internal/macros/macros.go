@@ -2,6 +2,7 @@ package macros
 
 import (
 	datetime "go-astronomy/internal/dateTime"
+	vecmat "go-astronomy/internal/vecMat"
 	"math"
 )
 
@@ -149,6 +150,14 @@ func ConvertDegreesToRadiance(degrees float64) float64 {
 	return degrees * (math.Pi / 180)
 }
 
+func SinDeg(degrees float64) float64 {
+	return math.Sin(ConvertDegreesToRadiance(degrees))
+}
+
+func CosDeg(degrees float64) float64 {
+	return math.Cos(ConvertDegreesToRadiance(degrees))
+}
+
 func ConvertDecimalDegressToDecimalHrs(decimalDeg float64) float64 {
 	const degreesPerHour = 15.0
 	return decimalDeg / degreesPerHour
@@ -181,26 +190,12 @@ func ConvertEclipticCoordinatesToEquatorial(day float64, month, year, eclipticLo
 	eclipticLongDecimalDeg := ConvertDegMinSecToDecimalDeg(eclipticLongDeg, eclipticLongMin, eclipticLongSec)
 	eclipticLatDecimalDeg := ConvertDegMinSecToDecimalDeg(eclipticLatDeg, eclipticLatMin, eclipticLatSec)
 
-	// Convert meanObliquity to radians
-	meanObliquityRad := ConvertDegreesToRadiance(meanObliquity)
-
-	// Convert ecliptic longitude and latitude to radians
-	eclipticLongRad := ConvertDegreesToRadiance(eclipticLongDecimalDeg)
-	eclipticLatRad := ConvertDegreesToRadiance(eclipticLatDecimalDeg)
-
-	// Calculate Declination
-	decRad := math.Asin((math.Sin(eclipticLatRad) * math.Cos(meanObliquityRad)) + (math.Cos(eclipticLatRad) * math.Sin(meanObliquityRad) * math.Sin(eclipticLongRad)))
-	decDecimalDeg := ConvertRadianceToDegree(decRad)
+	// Rotate from the ecliptic to the equator, atan2 keeping the right ascension in the right quadrant
+	raDeg, decDecimalDeg := vecmat.CalculateRotationX(-meanObliquity).ApplyDecimalDeg(eclipticLongDecimalDeg, eclipticLatDecimalDeg)
 	decDeg, decMin, decSec = ConvertDecimalDegToDegMinSec(decDecimalDeg)
 
-	// Calculate Right Ascension
-	y := (math.Sin(eclipticLongRad) * math.Cos(meanObliquityRad)) - (math.Tan(eclipticLatRad) * math.Sin(meanObliquityRad))
-	x := math.Cos(eclipticLongRad)
-	raRad := math.Atan(y / x)
-	raDeg := AdjustAngleInQuadrant(x, y, ConvertRadianceToDegree(raRad))
 	raDecimalHrs := raDeg / 15.0                                       // Convert degrees to hours
 	raHrs, raMins, raSecs = ConvertDecimalDegToDegMinSec(raDecimalHrs) // Convert back to hours, minutes, and seconds
-	// fmt.Printf("\nmeanObliquity : %f\neclipticLongDecimalDeg : %f\neclipticLatDecimalDeg : %f\ndecRad : %f\ndecDecimalDeg : %f\nx : %f\ny : %f\nraDeg : %f\nraDecimalHrs : %f\n", meanObliquity, eclipticLongDecimalDeg, eclipticLatDecimalDeg, decRad, decDecimalDeg, x, y, raDeg, raDecimalHrs)
 
 	return raHrs, raMins, raSecs, decDeg, decMin, decSec
 }
@@ -235,13 +230,6 @@ func AdjustAngleInQuadrant(x, y, A float64) float64 {
 		} else {
 			A = 360 - A
 		}
-	} else {
-		// First quadrant
-		if A < 0 {
-			A = A
-		} else {
-			A = A
-		}
 	}
 
 	// Normalize the angle to be within [0, 360)
@@ -255,7 +243,11 @@ func AdjustAngleInQuadrant(x, y, A float64) float64 {
 }
 
 func CalculateEccentricAnomaly(M, e float64) (Erad float64) {
-	Erad = M // Initial guess for E is M
+	// Initial guess for E is M, Newton's method may diverge from there for very eccentric orbits
+	Erad = M
+	if e > 0.8 {
+		Erad = math.Pi
+	}
 
 	for {
 		delta := Erad - (e * math.Sin(Erad)) - M
@@ -0,0 +1,48 @@
+package macros
+
+import "math"
+
+// Ratio by which golden section search narrows its interval at each step
+var goldenRatio = (math.Sqrt(5) - 1) / 2
+
+func FindTransition(condition func(x float64) bool, start, end, tolerance float64) float64 {
+	// Point between start and end at which a condition changes, found by bisection to within tolerance or the
+	// precision of float64. The condition must differ at the two ends and change only once between them.
+	isTrueAtStart := condition(start)
+	for math.Abs(end-start) > tolerance {
+		middle := (start + end) / 2
+		if middle == start || middle == end {
+			break
+		}
+		if condition(middle) == isTrueAtStart {
+			start = middle
+		} else {
+			end = middle
+		}
+	}
+	return (start + end) / 2
+}
+
+func FindRoot(function func(x float64) float64, start, end, tolerance float64) float64 {
+	// Root of a function whose sign differs at start and end, found by bisection to within tolerance
+	return FindTransition(func(x float64) bool { return function(x) < 0 }, start, end, tolerance)
+}
+
+func FindMinimum(function func(x float64) float64, start, end, tolerance float64) float64 {
+	// Point of least value of a function with a single minimum between start and end, found by golden section
+	// search to within tolerance. A maximum is found as the minimum of the negated function.
+	x1, x2 := end-(goldenRatio*(end-start)), start+(goldenRatio*(end-start))
+	f1, f2 := function(x1), function(x2)
+	for math.Abs(end-start) > tolerance && x1 < x2 {
+		if f1 < f2 {
+			end, x2, f2 = x2, x1, f1
+			x1 = end - (goldenRatio * (end - start))
+			f1 = function(x1)
+		} else {
+			start, x1, f1 = x1, x2, f2
+			x2 = start + (goldenRatio * (end - start))
+			f2 = function(x2)
+		}
+	}
+	return (start + end) / 2
+}
@@ -0,0 +1,77 @@
+package moon
+
+import (
+	"go-astronomy/internal/coords"
+	datetime "go-astronomy/internal/dateTime"
+	"go-astronomy/internal/macros"
+	"math"
+)
+
+// Mean distance of the Moon (km) and the ratio of the Moon's radius to the Earth's equatorial radius
+const meanDistance = 385000.56
+const earthEquatorialRadius = 6378.14
+const RadiusRatio = 0.272481
+
+func CalculateEclipticCoordinatesOfMoon(julianEphemerisDate float64) (lambda, beta, distanceKm float64) {
+	// Geocentric ecliptic coordinates of the Moon referred to the mean equinox of date (Meeus chapter 47)
+	T := (julianEphemerisDate - 2451545.0) / 36525.0
+
+	LDash := 218.3164477 + (481267.88123421 * T) - (0.0015786 * math.Pow(T, 2)) + (math.Pow(T, 3) / 538841) - (math.Pow(T, 4) / 65194000)
+	D := 297.8501921 + (445267.1114034 * T) - (0.0018819 * math.Pow(T, 2)) + (math.Pow(T, 3) / 545868) - (math.Pow(T, 4) / 113065000)
+	M := 357.5291092 + (35999.0502909 * T) - (0.0001536 * math.Pow(T, 2)) + (math.Pow(T, 3) / 24490000)
+	MDash := 134.9633964 + (477198.8675055 * T) + (0.0087414 * math.Pow(T, 2)) + (math.Pow(T, 3) / 69699) - (math.Pow(T, 4) / 14712000)
+	F := 93.2720950 + (483202.0175233 * T) - (0.0036539 * math.Pow(T, 2)) - (math.Pow(T, 3) / 3526000) + (math.Pow(T, 4) / 863310000)
+
+	A1 := 119.75 + (131.849 * T)
+	A2 := 53.09 + (479264.290 * T)
+	A3 := 313.45 + (481266.484 * T)
+	E := 1 - (0.002516 * T) - (0.0000074 * math.Pow(T, 2))
+
+	sumL := (3958 * math.Sin(macros.ConvertDegreesToRadiance(A1))) + (1962 * math.Sin(macros.ConvertDegreesToRadiance(LDash-F))) + (318 * math.Sin(macros.ConvertDegreesToRadiance(A2)))
+	sumR := 0.0
+	sumB := -(2235 * math.Sin(macros.ConvertDegreesToRadiance(LDash))) + (382 * math.Sin(macros.ConvertDegreesToRadiance(A3))) + (175 * math.Sin(macros.ConvertDegreesToRadiance(A1-F))) + (175 * math.Sin(macros.ConvertDegreesToRadiance(A1+F))) + (127 * math.Sin(macros.ConvertDegreesToRadiance(LDash-MDash))) - (115 * math.Sin(macros.ConvertDegreesToRadiance(LDash+MDash)))
+
+	for _, term := range LongitudeAndDistanceTerms {
+		argument := macros.ConvertDegreesToRadiance((term[0] * D) + (term[1] * M) + (term[2] * MDash) + (term[3] * F))
+		// Terms containing the Sun's mean anomaly are multiplied by E to allow for the decreasing eccentricity of the Earth's orbit
+		eccentricityFactor := math.Pow(E, math.Abs(term[1]))
+		sumL += term[4] * eccentricityFactor * math.Sin(argument)
+		sumR += term[5] * eccentricityFactor * math.Cos(argument)
+	}
+
+	for _, term := range LatitudeTerms {
+		argument := macros.ConvertDegreesToRadiance((term[0] * D) + (term[1] * M) + (term[2] * MDash) + (term[3] * F))
+		sumB += term[4] * math.Pow(E, math.Abs(term[1])) * math.Sin(argument)
+	}
+
+	lambda = macros.AdjustAngleRange(math.Mod(LDash, 360)+(sumL/1000000), 0, 360)
+	beta = sumB / 1000000
+	distanceKm = meanDistance + (sumR / 1000)
+
+	return lambda, beta, distanceKm
+}
+
+func CalculateHorizontalParallaxOfMoon(distanceKm float64) float64 {
+	return macros.ConvertRadianceToDegree(math.Asin(earthEquatorialRadius / distanceKm))
+}
+
+func CalculateSemiDiameterOfMoon(distanceKm float64) float64 {
+	// Geocentric semi-diameter in decimal degrees
+	return macros.ConvertRadianceToDegree(math.Asin(RadiusRatio * (earthEquatorialRadius / distanceKm)))
+}
+
+func CalculateApparentPositionOfMoon(julianEphemerisDate float64) (raHrs, raMin int, raSec float64, decDeg, decMin int, decSec, raDecimalHrs, decDecimalDeg, distanceKm float64) {
+	lambda, beta, distanceKm := CalculateEclipticCoordinatesOfMoon(julianEphemerisDate)
+
+	nutationInLong, _ := coords.CalculateNutationIAU2000B(julianEphemerisDate)
+	apparentLambda := lambda + nutationInLong
+	trueObliquity := coords.CalculateTrueObliquity(julianEphemerisDate)
+
+	raDecimalDeg, decDecimalDeg := coords.ConvertEclipticDecimalDegToEquatorial(apparentLambda, beta, trueObliquity)
+	raDecimalHrs = macros.ConvertDecimalDegressToDecimalHrs(raDecimalDeg)
+
+	raHrs, raMin, raSec = datetime.ConvertDecimalHrsToHrsMinSec(raDecimalHrs)
+	decDeg, decMin, decSec = macros.ConvertDecimalDegToDegMinSec(decDecimalDeg)
+
+	return raHrs, raMin, raSec, decDeg, decMin, decSec, raDecimalHrs, decDecimalDeg, distanceKm
+}
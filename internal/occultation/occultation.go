@@ -0,0 +1,300 @@
+package occultation
+
+import (
+	"go-astronomy/internal/coords"
+	datetime "go-astronomy/internal/dateTime"
+	"go-astronomy/internal/macros"
+	"go-astronomy/internal/moon"
+	"go-astronomy/internal/planets"
+	starcatalog "go-astronomy/internal/starCatalog"
+	"go-astronomy/internal/sun"
+	"math"
+)
+
+// Radius of the Moon used for occultation work in units of the Earth's equatorial radius, and the
+// square of the eccentricity of the Earth's meridian ellipse
+const moonRadius = 0.2725076
+const earthEquatorialRadiusKm = 6378.137
+const astronomicalUnitKm = 149597870.7
+const earthEccentricitySquared = 1 - (0.996647 * 0.996647)
+
+// OccultationEvent describes a disappearance or reappearance. Time is a Julian date in UT, PositionAngle is
+// measured on the lunar limb from the north point through east and CuspAngle is measured from the nearest
+// cusp ("N" or "S"), positive on the dark limb and negative on the bright limb.
+type OccultationEvent struct {
+	Time          float64
+	PositionAngle float64
+	CuspAngle     float64
+	Cusp          string
+	MoonAltitude  float64
+}
+
+// Occultation holds the circumstances of the occultation of a star or planet for one place
+type Occultation struct {
+	Name          string
+	Disappearance OccultationEvent
+	Reappearance  OccultationEvent
+}
+
+// GrazeLimitPoint is a point on the northern or southern limit of an occultation, Time is a Julian date in UT
+type GrazeLimitPoint struct {
+	Time    float64
+	GeoLatN float64
+	GeoLong float64
+}
+
+// targetPosition returns the apparent geocentric RA and Dec in decimal degrees and the distance in Earth radii
+type targetPosition func(julianEphemerisDate float64) (raDecimalDeg, decDecimalDeg, distanceEarthRadii float64)
+
+func CalculateApparentPositionOfStar(star starcatalog.Star, julianEphemerisDate float64) (raDecimalHrs, decDecimalDeg float64) {
+	// Space motion from the catalogue epoch to the date, precession from J2000.0 to the date followed by nutation
+	// and annual aberration
+	star = starcatalog.PropagateStar(star, datetime.ConvertJulianDateToJulianEpoch(julianEphemerisDate))
+	precessed := coords.EquatorialCoordinates{RADecimalHrs: star.RADecimalHrs, DecDecimalDeg: star.DecDecimalDeg}.Precess(2451545.0, julianEphemerisDate)
+	raDecimalDeg, decDecimalDeg := precessed.RADecimalHrs*15, precessed.DecDecimalDeg
+
+	nutationInLong, _ := coords.CalculateNutationIAU2000B(julianEphemerisDate)
+	meanObliquity := coords.CalculateMeanObliquityIAU2006(julianEphemerisDate)
+	sunTrueLongitude, _, _ := sun.CalculateEclipticCoordinatesOfSun(julianEphemerisDate)
+
+	lambda, beta := coords.ConvertEquatorialDecimalDegToEcliptic(raDecimalDeg, decDecimalDeg, meanObliquity)
+	deltaLambda, deltaBeta := coords.CalculateAberrationDecimalDeg(julianEphemerisDate, lambda, beta, sunTrueLongitude)
+	raDecimalDeg, decDecimalDeg = coords.ConvertEclipticDecimalDegToEquatorial(lambda+deltaLambda+nutationInLong, beta+deltaBeta, coords.CalculateTrueObliquity(julianEphemerisDate))
+
+	return macros.ConvertDecimalDegressToDecimalHrs(raDecimalDeg), decDecimalDeg
+}
+
+func starPosition(star starcatalog.Star) targetPosition {
+	return func(julianEphemerisDate float64) (float64, float64, float64) {
+		raDecimalHrs, decDecimalDeg := CalculateApparentPositionOfStar(star, julianEphemerisDate)
+		return raDecimalHrs * 15, decDecimalDeg, math.Inf(1)
+	}
+}
+
+func planetPosition(planetName string) targetPosition {
+	return func(julianEphemerisDate float64) (float64, float64, float64) {
+		_, _, _, _, _, _, raDecimalHrs, decDecimalDeg, distanceAU := planets.CalculateApparentPositionOfPlanet(julianEphemerisDate, planetName)
+		return raDecimalHrs * 15, decDecimalDeg, distanceAU * astronomicalUnitKm / earthEquatorialRadiusKm
+	}
+}
+
+func calculateTopocentricGeometry(position targetPosition, julianDate, pSin, pCos, geoLong float64) (separation, moonSemiDiameter, positionAngle, moonRA, moonDec, moonHourAngle float64) {
+	// Separation of the target from the centre of the Moon and the Moon's semi-diameter as seen by the observer
+	julianEphemerisDate := datetime.ConvertUniversalTimeToEphemerisTime(julianDate)
+	_, _, _, _, _, _, moonRAHrs, moonDecDeg, moonDistanceKm := moon.CalculateApparentPositionOfMoon(julianEphemerisDate)
+	targetRA, targetDec, targetDistance := position(julianEphemerisDate)
+	localSiderealTime := coords.CalculateGreenwichApparentSiderealTime(julianDate) + geoLong
+
+	moonRA, moonDec, moonDistance := coords.ConvertGeocentricToTopocentricDecimalDeg(moonRAHrs*15, moonDecDeg, moonDistanceKm/earthEquatorialRadiusKm, localSiderealTime-(moonRAHrs*15), pSin, pCos)
+	if !math.IsInf(targetDistance, 1) {
+		targetRA, targetDec, _ = coords.ConvertGeocentricToTopocentricDecimalDeg(targetRA, targetDec, targetDistance, localSiderealTime-targetRA, pSin, pCos)
+	}
+
+	separation = coords.CalculateAngularSeparationDecimalDeg(moonRA, moonDec, targetRA, targetDec)
+	moonSemiDiameter = macros.ConvertRadianceToDegree(math.Asin(moonRadius / moonDistance))
+	positionAngle = coords.CalculatePositionAngleDecimalDeg(moonRA, moonDec, targetRA, targetDec)
+
+	return separation, moonSemiDiameter, positionAngle, moonRA, moonDec, localSiderealTime - moonRA
+}
+
+func calculateOccultationEvent(position targetPosition, julianDate, pSin, pCos, geoLatN, geoLong float64) OccultationEvent {
+	_, _, positionAngle, moonRA, moonDec, moonHourAngle := calculateTopocentricGeometry(position, julianDate, pSin, pCos, geoLong)
+	moonAltitude, _ := coords.ConvertHourAngleDecimalDegToHorizon(moonHourAngle, moonDec, geoLatN)
+
+	// The cusps lie 90 degrees either side of the position angle of the midpoint of the bright limb
+	_, _, _, _, _, _, sunRAHrs, sunDecDeg, _ := sun.CalculateApparentPositionOfSun(datetime.ConvertUniversalTimeToEphemerisTime(julianDate))
+	brightLimb := coords.CalculatePositionAngleDecimalDeg(moonRA, moonDec, sunRAHrs*15, sunDecDeg)
+	fromBrightLimb := macros.AdjustAngleRange(positionAngle-brightLimb+180, 0, 360) - 180
+	cuspPositionAngle := brightLimb + math.Copysign(90, fromBrightLimb)
+
+	event := OccultationEvent{
+		Time:          julianDate,
+		PositionAngle: positionAngle,
+		CuspAngle:     math.Abs(fromBrightLimb) - 90,
+		Cusp:          "S",
+		MoonAltitude:  moonAltitude,
+	}
+	if math.Cos(macros.ConvertDegreesToRadiance(cuspPositionAngle)) > 0 {
+		event.Cusp = "N"
+	}
+	return event
+}
+
+func calculateMoonTrack(startJulianDate, endJulianDate, step float64) (times, moonRA, moonDec []float64) {
+	for julianDate := startJulianDate; julianDate <= endJulianDate+step; julianDate += step {
+		_, _, _, _, _, _, raDecimalHrs, decDecimalDeg, _ := moon.CalculateApparentPositionOfMoon(datetime.ConvertUniversalTimeToEphemerisTime(julianDate))
+		times, moonRA, moonDec = append(times, julianDate), append(moonRA, raDecimalHrs*15), append(moonDec, decDecimalDeg)
+	}
+	return times, moonRA, moonDec
+}
+
+func findOccultations(name string, position, coarsePosition targetPosition, times, moonRA, moonDec []float64, startJulianDate, endJulianDate float64, observer coords.Observer) []Occultation {
+	geoLatN, geoLong := observer.GeoLatN, observer.GeoLong
+	pSin, pCos := coords.CalculateGeocentricParallax(observer.HeightFromSeaLevel, -geoLong, geoLatN)
+	occulted := func(julianDate float64) float64 {
+		separation, moonSemiDiameter, _, _, _, _ := calculateTopocentricGeometry(position, julianDate, pSin, pCos, geoLong)
+		return separation - moonSemiDiameter
+	}
+	findContact := func(start, end float64) float64 {
+		return macros.FindRoot(occulted, start, end, 1e-9)
+	}
+
+	// Close approaches of the Moon to the target are found geocentrically, the parallax of the Moon can displace
+	// it by up to about a degree and shift the topocentric conjunction by up to two hours
+	geocentricSeparations := make([]float64, len(times))
+	for i := range times {
+		targetRA, targetDec, _ := coarsePosition(datetime.ConvertUniversalTimeToEphemerisTime(times[i]))
+		geocentricSeparations[i] = coords.CalculateAngularSeparationDecimalDeg(moonRA[i], moonDec[i], targetRA, targetDec)
+	}
+
+	const step = 5.0 / 1440
+	occultations := []Occultation{}
+	for i := 1; i < len(times)-1; i++ {
+		if geocentricSeparations[i] > 1.5 || geocentricSeparations[i] > geocentricSeparations[i-1] || geocentricSeparations[i] > geocentricSeparations[i+1] {
+			continue
+		}
+
+		// Topocentric closest approach, scanned in steps of five minutes and refined with golden section search
+		tMinimum, minimum := 0.0, math.Inf(1)
+		for t := times[i] - 0.125; t <= times[i]+0.125; t += step {
+			if value := occulted(t); value < minimum {
+				tMinimum, minimum = t, value
+			}
+		}
+		tMinimum = macros.FindMinimum(occulted, tMinimum-step, tMinimum+step, 1e-9)
+		if occulted(tMinimum) >= 0 || tMinimum < startJulianDate || tMinimum > endJulianDate {
+			continue
+		}
+
+		tStart, tEnd := tMinimum, tMinimum
+		for occulted(tStart) < 0 {
+			tStart -= step
+		}
+		for occulted(tEnd) < 0 {
+			tEnd += step
+		}
+		occultation := Occultation{
+			Name:          name,
+			Disappearance: calculateOccultationEvent(position, findContact(tStart, tMinimum), pSin, pCos, geoLatN, geoLong),
+			Reappearance:  calculateOccultationEvent(position, findContact(tMinimum, tEnd), pSin, pCos, geoLatN, geoLong),
+		}
+		if occultation.Disappearance.MoonAltitude > 0 || occultation.Reappearance.MoonAltitude > 0 {
+			occultations = append(occultations, occultation)
+		}
+	}
+	return occultations
+}
+
+func CalculateStarOccultations(stars []starcatalog.Star, startJulianDate, endJulianDate float64, observer coords.Observer) []Occultation {
+	// Times are Julian dates in UT. Occultations are returned star by star when the Moon is above the horizon at either event.
+	times, moonRA, moonDec := calculateMoonTrack(startJulianDate-0.125, endJulianDate+0.125, 1.0/24)
+	middle := datetime.ConvertUniversalTimeToEphemerisTime((startJulianDate + endJulianDate) / 2)
+
+	occultations := []Occultation{}
+	for _, star := range stars {
+		raDecimalHrs, decDecimalDeg := CalculateApparentPositionOfStar(star, middle)
+		coarsePosition := func(float64) (float64, float64, float64) {
+			return raDecimalHrs * 15, decDecimalDeg, math.Inf(1)
+		}
+		occultations = append(occultations, findOccultations(star.Name, starPosition(star), coarsePosition, times, moonRA, moonDec, startJulianDate, endJulianDate, observer)...)
+	}
+	return occultations
+}
+
+func CalculatePlanetOccultations(planetName string, startJulianDate, endJulianDate float64, observer coords.Observer) []Occultation {
+	// Events refer to the centre of the planet's disk
+	times, moonRA, moonDec := calculateMoonTrack(startJulianDate-0.125, endJulianDate+0.125, 1.0/24)
+	return findOccultations(planetName, planetPosition(planetName), planetPosition(planetName), times, moonRA, moonDec, startJulianDate, endJulianDate, observer)
+}
+
+func calculateFundamentalPlane(position targetPosition, julianDate float64) (x, y, d, greenwichHourAngle float64) {
+	// Position of the Moon on the plane through the centre of the Earth perpendicular to the line from the Moon
+	// to the target, in Earth radii, together with the declination and Greenwich hour angle of that line
+	julianEphemerisDate := datetime.ConvertUniversalTimeToEphemerisTime(julianDate)
+	_, _, _, _, _, _, moonRAHrs, moonDecDeg, moonDistanceKm := moon.CalculateApparentPositionOfMoon(julianEphemerisDate)
+	targetRA, targetDec, targetDistance := position(julianEphemerisDate)
+
+	moonRA, moonDec := macros.ConvertDegreesToRadiance(moonRAHrs*15), macros.ConvertDegreesToRadiance(moonDecDeg)
+	moonDistance := moonDistanceKm / earthEquatorialRadiusKm
+	a, dRad := macros.ConvertDegreesToRadiance(targetRA), macros.ConvertDegreesToRadiance(targetDec)
+	if !math.IsInf(targetDistance, 1) {
+		gx := (targetDistance * math.Cos(dRad) * math.Cos(a)) - (moonDistance * math.Cos(moonDec) * math.Cos(moonRA))
+		gy := (targetDistance * math.Cos(dRad) * math.Sin(a)) - (moonDistance * math.Cos(moonDec) * math.Sin(moonRA))
+		gz := (targetDistance * math.Sin(dRad)) - (moonDistance * math.Sin(moonDec))
+		a, dRad = math.Atan2(gy, gx), math.Atan2(gz, math.Sqrt(math.Pow(gx, 2)+math.Pow(gy, 2)))
+	}
+
+	x = moonDistance * math.Cos(moonDec) * math.Sin(moonRA-a)
+	y = moonDistance * ((math.Sin(moonDec) * math.Cos(dRad)) - (math.Cos(moonDec) * math.Sin(dRad) * math.Cos(moonRA-a)))
+	greenwichHourAngle = coords.CalculateGreenwichApparentSiderealTime(julianDate) - macros.ConvertRadianceToDegree(a)
+
+	return x, y, macros.ConvertRadianceToDegree(dRad), greenwichHourAngle
+}
+
+func calculateGrazeLimits(position targetPosition, startJulianDate, endJulianDate float64) (northernLimit, southernLimit []GrazeLimitPoint) {
+	const step = 1.0 / 1440
+	const hourlyRotation = 0.2625161 // Rotation of the Earth in radians per hour
+
+	for julianDate := startJulianDate; julianDate <= endJulianDate; julianDate += step {
+		x, y, d, greenwichHourAngle := calculateFundamentalPlane(position, julianDate)
+		x1, y1, _, _ := calculateFundamentalPlane(position, julianDate+step)
+		xDash, yDash := (x1-x)*60, (y1-y)*60
+		dRad := macros.ConvertDegreesToRadiance(d)
+
+		// The limit lines are traced by the edges of the Moon's shadow, on each side of the path, where the motion of the
+		// shadow relative to the observer is parallel to the edge
+		for _, side := range []float64{1, -1} {
+			onEarth := true
+			geoLatN, geoLong := 0.0, 0.0
+			xiDash, etaDash := 0.0, 0.0
+			for i := 0; i < 4; i++ {
+				u, v := xDash-xiDash, yDash-etaDash
+				normalX, normalY := -v/math.Hypot(u, v), u/math.Hypot(u, v)
+				if normalY < 0 {
+					normalX, normalY = -normalX, -normalY
+				}
+				xi, eta := x+(side*moonRadius*normalX), y+(side*moonRadius*normalY)
+
+				// Convert the point on the fundamental plane to geodetic latitude and longitude on the spheroid
+				rho1 := math.Sqrt(1 - (earthEccentricitySquared * math.Pow(math.Cos(dRad), 2)))
+				sinD1, cosD1 := math.Sin(dRad)/rho1, math.Sqrt(1-earthEccentricitySquared)*math.Cos(dRad)/rho1
+				eta1 := eta / rho1
+				if math.Pow(xi, 2)+math.Pow(eta1, 2) > 1 {
+					onEarth = false
+					break
+				}
+				zeta1 := math.Sqrt(1 - math.Pow(xi, 2) - math.Pow(eta1, 2))
+				phi1 := math.Asin((eta1 * cosD1) + (zeta1 * sinD1))
+				geoLatN = macros.ConvertRadianceToDegree(math.Atan(math.Tan(phi1) / math.Sqrt(1-earthEccentricitySquared)))
+				H := macros.ConvertRadianceToDegree(math.Atan2(xi, (zeta1*cosD1)-(eta1*sinD1)))
+				geoLong = macros.AdjustAngleRange(H-greenwichHourAngle+180, 0, 360) - 180
+
+				// Velocity of the observer on the fundamental plane due to the rotation of the Earth
+				_, pCos := coords.CalculateGeocentricParallax(0, -geoLong, geoLatN)
+				HRad := macros.ConvertDegreesToRadiance(H)
+				xiDash = hourlyRotation * pCos * math.Cos(HRad)
+				etaDash = hourlyRotation * pCos * math.Sin(HRad) * math.Sin(dRad)
+			}
+			if !onEarth {
+				continue
+			}
+			point := GrazeLimitPoint{Time: julianDate, GeoLatN: geoLatN, GeoLong: geoLong}
+			if side > 0 {
+				northernLimit = append(northernLimit, point)
+			} else {
+				southernLimit = append(southernLimit, point)
+			}
+		}
+	}
+	return northernLimit, southernLimit
+}
+
+func CalculateStarGrazeLimits(star starcatalog.Star, startJulianDate, endJulianDate float64) (northernLimit, southernLimit []GrazeLimitPoint) {
+	// Points on the Earth's surface at sea level, one a minute, where the star grazes the northern or southern limb
+	// of the Moon. Times are Julian dates in UT and longitudes are positive east of Greenwich.
+	return calculateGrazeLimits(starPosition(star), startJulianDate, endJulianDate)
+}
+
+func CalculatePlanetGrazeLimits(planetName string, startJulianDate, endJulianDate float64) (northernLimit, southernLimit []GrazeLimitPoint) {
+	return calculateGrazeLimits(planetPosition(planetName), startJulianDate, endJulianDate)
+}
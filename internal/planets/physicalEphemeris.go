@@ -0,0 +1,152 @@
+package planets
+
+import (
+	"go-astronomy/internal/coords"
+	"go-astronomy/internal/macros"
+	"math"
+)
+
+// MarsPhysicalEphemeris holds the quantities for physical observations of Mars (Meeus chapter 42). DE and DS
+// are the planetocentric declinations of the Earth and the Sun, CentralMeridian the areographic longitude of the
+// centre of the disk, PositionAngle that of the northern rotation pole and PositionAngleOfDefect that of the
+// greatest defect of illumination, all in decimal degrees. The diameter and the defect are in arcseconds.
+type MarsPhysicalEphemeris struct {
+	DE                    float64
+	DS                    float64
+	CentralMeridian       float64
+	PositionAngle         float64
+	PositionAngleOfDefect float64
+	ApparentDiameter      float64
+	IlluminatedFraction   float64
+	DefectOfIllumination  float64
+}
+
+// JupiterPhysicalEphemeris holds the quantities for physical observations of Jupiter (Meeus chapter 43). The
+// central meridians of System I and System II are those of the illuminated disk. Angles are in decimal degrees,
+// the equatorial diameter and the defect of illumination in arcseconds.
+type JupiterPhysicalEphemeris struct {
+	DE                      float64
+	DS                      float64
+	CentralMeridianSystemI  float64
+	CentralMeridianSystemII float64
+	PositionAngle           float64
+	ApparentDiameter        float64
+	IlluminatedFraction     float64
+	DefectOfIllumination    float64
+}
+
+func calculatePlanetocentricDeclination(poleRA, poleDec, ra, dec float64) float64 {
+	// Planetocentric declination of a body seen in the direction ra, dec from the planet, all in decimal degrees
+	poleRARad, poleDecRad := macros.ConvertDegreesToRadiance(poleRA), macros.ConvertDegreesToRadiance(poleDec)
+	raRad, decRad := macros.ConvertDegreesToRadiance(ra), macros.ConvertDegreesToRadiance(dec)
+	return macros.ConvertRadianceToDegree(math.Asin((-math.Sin(poleDecRad) * math.Sin(decRad)) - (math.Cos(poleDecRad) * math.Cos(decRad) * math.Cos(poleRARad-raRad))))
+}
+
+func calculateZeta(poleRA, poleDec, ra, dec float64) float64 {
+	// Angle between the node of the planet's equator on the celestial equator and the direction of the Earth
+	poleRARad, poleDecRad := macros.ConvertDegreesToRadiance(poleRA), macros.ConvertDegreesToRadiance(poleDec)
+	raRad, decRad := macros.ConvertDegreesToRadiance(ra), macros.ConvertDegreesToRadiance(dec)
+	return macros.ConvertRadianceToDegree(math.Atan2((math.Sin(poleDecRad)*math.Cos(decRad)*math.Cos(poleRARad-raRad))-(math.Sin(decRad)*math.Cos(poleDecRad)), math.Cos(decRad)*math.Sin(poleRARad-raRad)))
+}
+
+func calculateIlluminatedFraction(r, distanceAU, earthRadius float64) float64 {
+	return (math.Pow(r+distanceAU, 2) - math.Pow(earthRadius, 2)) / (4 * r * distanceAU)
+}
+
+func CalculatePhysicalEphemerisOfMars(julianEphemerisDate float64) MarsPhysicalEphemeris {
+	// Pole of Mars referred to the ecliptic and mean equinox of date
+	T := (julianEphemerisDate - 2451545.0) / 36525.0
+	poleLambda := 352.9065 + (1.17330 * T)
+	poleBeta := 63.2818 - (0.00394 * T)
+
+	x, y, z, distanceAU, lightTime, l, b, r := CalculateGeometricPositionOfPlanet(julianEphemerisDate, "Mars")
+	earthLongitude, _, earthRadius := CalculateHeliocentricEclipticCoordinatesOfPlanet(julianEphemerisDate, "Earth")
+	lambda := macros.ConvertRadianceToDegree(math.Atan2(y, x))
+	beta := macros.ConvertRadianceToDegree(math.Atan2(z, math.Sqrt(math.Pow(x, 2)+math.Pow(y, 2))))
+
+	ephemeris := MarsPhysicalEphemeris{}
+	ephemeris.DE = calculatePlanetocentricDeclination(poleLambda, poleBeta, lambda, beta)
+
+	// Heliocentric position corrected for the aberration of Mars as seen from the Sun
+	N := 49.5581 + (0.7721 * T)
+	lDash := l - (0.00697 / r)
+	bDash := b - (0.000225 * math.Cos(macros.ConvertDegreesToRadiance(l-N)) / r)
+	ephemeris.DS = calculatePlanetocentricDeclination(poleLambda, poleBeta, lDash, bDash)
+
+	// Central meridian from the rotation of Mars at the instant the light left it
+	W := 11.504 + (350.89200025 * (julianEphemerisDate - lightTime - 2433282.5))
+	meanObliquity := coords.CalculateMeanObliquityIAU2006(julianEphemerisDate)
+	poleRA, poleDec := coords.ConvertEclipticDecimalDegToEquatorial(poleLambda, poleBeta, meanObliquity)
+	ra, dec := coords.ConvertEclipticDecimalDegToEquatorial(lambda, beta, meanObliquity)
+	ephemeris.CentralMeridian = macros.AdjustAngleRange(math.Mod(W-calculateZeta(poleRA, poleDec, ra, dec), 360), 0, 360)
+
+	// Position angles from the apparent places, corrected for aberration and nutation
+	nutationInLong, _ := coords.CalculateNutationIAU2000B(julianEphemerisDate)
+	obliquity := coords.CalculateTrueObliquity(julianEphemerisDate)
+	lambdaRad, betaRad := macros.ConvertDegreesToRadiance(lambda), macros.ConvertDegreesToRadiance(beta)
+	earthL := macros.ConvertDegreesToRadiance(earthLongitude)
+	lambda += 0.005693 * math.Cos(earthL-lambdaRad) / math.Cos(betaRad)
+	beta += 0.005693 * math.Sin(earthL-lambdaRad) * math.Sin(betaRad)
+	poleRA, poleDec = coords.ConvertEclipticDecimalDegToEquatorial(poleLambda+nutationInLong, poleBeta, obliquity)
+	ra, dec = coords.ConvertEclipticDecimalDegToEquatorial(lambda+nutationInLong, beta, obliquity)
+	ephemeris.PositionAngle = coords.CalculatePositionAngleDecimalDeg(ra, dec, poleRA, poleDec)
+	sunRA, sunDec := coords.ConvertEclipticDecimalDegToEquatorial(earthLongitude+180, 0, obliquity)
+	ephemeris.PositionAngleOfDefect = macros.AdjustAngleRange(coords.CalculatePositionAngleDecimalDeg(ra, dec, sunRA, sunDec)+180, 0, 360)
+
+	ephemeris.ApparentDiameter = 9.36 / distanceAU
+	ephemeris.IlluminatedFraction = calculateIlluminatedFraction(r, distanceAU, earthRadius)
+	ephemeris.DefectOfIllumination = ephemeris.ApparentDiameter * (1 - ephemeris.IlluminatedFraction)
+
+	return ephemeris
+}
+
+func CalculatePhysicalEphemerisOfJupiter(julianEphemerisDate float64) JupiterPhysicalEphemeris {
+	// Pole of Jupiter and the rotation of Systems I and II, with time counted from 1950.0. The pole is fixed in
+	// space and the rates of its coordinates are those of precession, so it is referred to the mean equator and
+	// equinox of date like the positions below, and gives 268.05 and 64.49 degrees at J2000.0.
+	d := julianEphemerisDate - 2433282.5
+	T1 := d / 36525.0
+	poleRA := 268.00 + (0.1061 * T1)
+	poleDec := 64.50 - (0.0164 * T1)
+	W1 := 17.710 + (877.90003539 * d)
+	W2 := 16.838 + (870.27003539 * d)
+
+	x, y, z, distanceAU, _, l, b, r := CalculateGeometricPositionOfPlanet(julianEphemerisDate, "Jupiter")
+	earthLongitude, _, earthRadius := CalculateHeliocentricEclipticCoordinatesOfPlanet(julianEphemerisDate, "Earth")
+	meanObliquity := coords.CalculateMeanObliquityIAU2006(julianEphemerisDate)
+
+	ephemeris := JupiterPhysicalEphemeris{}
+	sunRA, sunDec := coords.ConvertEclipticDecimalDegToEquatorial(l, b, meanObliquity)
+	ephemeris.DS = calculatePlanetocentricDeclination(poleRA, poleDec, sunRA, sunDec)
+	lambda := macros.ConvertRadianceToDegree(math.Atan2(y, x))
+	beta := macros.ConvertRadianceToDegree(math.Atan2(z, math.Sqrt(math.Pow(x, 2)+math.Pow(y, 2))))
+	ra, dec := coords.ConvertEclipticDecimalDegToEquatorial(lambda, beta, meanObliquity)
+	ephemeris.DE = calculatePlanetocentricDeclination(poleRA, poleDec, ra, dec)
+
+	// Central meridians corrected for light-time and for the phase, so that they refer to the illuminated disk
+	zeta := calculateZeta(poleRA, poleDec, ra, dec)
+	phaseCorrection := macros.ConvertRadianceToDegree((2*r*distanceAU + math.Pow(earthRadius, 2) - math.Pow(r, 2) - math.Pow(distanceAU, 2)) / (4 * r * distanceAU))
+	if math.Sin(macros.ConvertDegreesToRadiance(l-earthLongitude)) < 0 {
+		phaseCorrection = -phaseCorrection
+	}
+	ephemeris.CentralMeridianSystemI = macros.AdjustAngleRange(math.Mod(W1-zeta-(5.07033*distanceAU)+phaseCorrection, 360), 0, 360)
+	ephemeris.CentralMeridianSystemII = macros.AdjustAngleRange(math.Mod(W2-zeta-(5.02626*distanceAU)+phaseCorrection, 360), 0, 360)
+
+	// Position angle of the pole from the apparent places, corrected for aberration and nutation
+	nutationInLong, _ := coords.CalculateNutationIAU2000B(julianEphemerisDate)
+	obliquity := coords.CalculateTrueObliquity(julianEphemerisDate)
+	lambdaRad, betaRad := macros.ConvertDegreesToRadiance(lambda), macros.ConvertDegreesToRadiance(beta)
+	earthL := macros.ConvertDegreesToRadiance(earthLongitude)
+	lambda += 0.005693 * math.Cos(earthL-lambdaRad) / math.Cos(betaRad)
+	beta += 0.005693 * math.Sin(earthL-lambdaRad) * math.Sin(betaRad)
+	ra, dec = coords.ConvertEclipticDecimalDegToEquatorial(lambda+nutationInLong, beta, obliquity)
+	poleLambda, poleBeta := coords.ConvertEquatorialDecimalDegToEcliptic(poleRA, poleDec, meanObliquity)
+	poleRA, poleDec = coords.ConvertEclipticDecimalDegToEquatorial(poleLambda+nutationInLong, poleBeta, obliquity)
+	ephemeris.PositionAngle = coords.CalculatePositionAngleDecimalDeg(ra, dec, poleRA, poleDec)
+
+	ephemeris.ApparentDiameter = 196.88 / distanceAU
+	ephemeris.IlluminatedFraction = calculateIlluminatedFraction(r, distanceAU, earthRadius)
+	ephemeris.DefectOfIllumination = ephemeris.ApparentDiameter * (1 - ephemeris.IlluminatedFraction)
+
+	return ephemeris
+}
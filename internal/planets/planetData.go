@@ -94,4 +94,1453 @@ var PlanetData = map[string]map[string]interface{}{
 
 func GetPlanetData(planetName string) map[string]interface{} {
 	return PlanetData[planetName]
-}
\ No newline at end of file
+}
+
+// OrbitalElements holds the mean orbital elements of each planet referred to the mean equinox of date
+// (Meeus table 31.A). Each row is a cubic polynomial in T, the Julian centuries from J2000.0, for the mean
+// longitude L, semi-major axis a (AU), eccentricity e, inclination i, longitude of the ascending node and
+// longitude of the perihelion, in that order.
+var OrbitalElements = map[string][6][4]float64{
+	"Mercury": {
+		{252.250906, 149474.0722491, 0.00030350, 0.000000018},
+		{0.387098310, 0, 0, 0},
+		{0.20563175, 0.000020407, -0.0000000283, -0.00000000018},
+		{7.004986, 0.0018215, -0.00001810, 0.000000056},
+		{48.330893, 1.1861883, 0.00017542, 0.000000215},
+		{77.456119, 1.5564776, 0.00029544, 0.000000009},
+	},
+	"Venus": {
+		{181.979801, 58519.2130302, 0.00031014, 0.000000015},
+		{0.723329820, 0, 0, 0},
+		{0.00677192, -0.000047765, 0.0000000981, 0.00000000046},
+		{3.394662, 0.0010037, -0.00000088, -0.000000007},
+		{76.679920, 0.9011206, 0.00040618, -0.000000093},
+		{131.563703, 1.4022288, -0.00107618, -0.000005678},
+	},
+	"Earth": {
+		{100.466457, 36000.7698278, 0.00030322, 0.000000020},
+		{1.000001018, 0, 0, 0},
+		{0.01670863, -0.000042037, -0.0000001267, 0.00000000014},
+		{0, 0, 0, 0},
+		{0, 0, 0, 0},
+		{102.937348, 1.7195366, 0.00045688, -0.000000018},
+	},
+	"Mars": {
+		{355.433000, 19141.6964471, 0.00031052, 0.000000016},
+		{1.523679342, 0, 0, 0},
+		{0.09340065, 0.000090484, -0.0000000806, -0.00000000025},
+		{1.849726, -0.0006011, 0.00001276, -0.000000007},
+		{49.558093, 0.7720959, 0.00001557, 0.000002267},
+		{336.060234, 1.8410449, 0.00013477, 0.000000536},
+	},
+	"Jupiter": {
+		{34.351519, 3036.3027748, 0.00022330, 0.000000037},
+		{5.202603209, 0.0000001913, 0, 0},
+		{0.04849793, 0.000163225, -0.0000004714, -0.00000000201},
+		{1.303267, -0.0054965, 0.00000466, -0.000000002},
+		{100.464407, 1.0209774, 0.00040315, 0.000000404},
+		{14.331207, 1.6126352, 0.00103042, -0.000004464},
+	},
+	"Saturn": {
+		{50.077444, 1223.5110686, 0.00051908, -0.000000030},
+		{9.554909192, -0.0000021390, 0.000000004, 0},
+		{0.05554814, -0.000346641, -0.0000006436, 0.00000000340},
+		{2.488879, -0.0037362, -0.00001519, 0.000000087},
+		{113.665503, 0.8770880, -0.00012176, -0.000002249},
+		{93.057237, 1.9637613, 0.00083753, 0.000004928},
+	},
+	"Uranus": {
+		{314.055005, 429.8640561, 0.00030390, 0.000000026},
+		{19.218446062, -0.0000000372, 0.00000000098, 0},
+		{0.04638122, -0.000027293, 0.0000000789, 0.00000000024},
+		{0.773197, 0.0007744, 0.00003749, -0.000000092},
+		{74.005957, 0.5211278, 0.00133947, 0.000018484},
+		{173.005291, 1.4863790, 0.00021406, 0.000000434},
+	},
+	"Neptune": {
+		{304.348665, 219.8833092, 0.00030882, 0.000000018},
+		{30.110386869, -0.0000001663, 0.00000000069, 0},
+		{0.00945575, 0.000006033, 0, -0.00000000005},
+		{1.769953, -0.0093082, -0.00000708, 0.000000027},
+		{131.784057, 1.1022039, 0.00025952, -0.000000637},
+		{48.120276, 1.4262957, 0.00038434, 0.000000020},
+	},
+}
+
+// LongitudePerturbations holds the largest periodic terms of the heliocentric longitude of Venus from VSOP87
+// which are missing from the mean orbital elements. Each term is A cos(B + C * tau) in radians,
+// tau being the Julian millennia from J2000.0.
+var LongitudePerturbations = map[string][][3]float64{
+	"Venus": {
+		{0.00005477194, 4.41630661466, 7860.41939243920},
+		{0.00003455741, 2.69964447820, 11790.62908865880},
+		{0.00002372061, 2.99377542079, 3930.20969621960},
+		{0.00001664146, 4.25018630147, 1577.34354244780},
+		{0.00001438387, 4.15745084182, 9683.59458111640},
+		{0.00001317168, 5.18668228402, 26.29831979980},
+	},
+}
+
+// PeriodicTerms holds the periodic terms of VSOP87 as truncated by Meeus (appendix III) for the heliocentric
+// longitude, latitude and radius vector of the planets whose mean orbital elements are not accurate enough.
+// Each coordinate is a series in powers of tau, the Julian millennia from J2000.0, and each term of a series is
+// A cos(B + C * tau) with A in units of 1e-8 radians or AU.
+var PeriodicTerms = map[string][3][][][3]float64{
+	"Earth": {
+		{
+			{
+				{175347046, 0, 0},
+				{3341656, 4.6692568, 6283.07585},
+				{34894, 4.6261, 12566.1517},
+				{3497, 2.7441, 5753.3849},
+				{3418, 2.8289, 3.5231},
+				{3136, 3.6277, 77713.7715},
+				{2676, 4.4181, 7860.4194},
+				{2343, 6.1352, 3930.2097},
+				{1324, 0.7425, 11506.7698},
+				{1273, 2.0371, 529.691},
+				{1199, 1.1096, 1577.3435},
+				{990, 5.233, 5884.927},
+				{902, 2.045, 26.298},
+				{857, 3.508, 398.149},
+				{780, 1.179, 5223.694},
+				{753, 2.533, 5507.553},
+				{505, 4.583, 18849.228},
+				{492, 4.205, 775.523},
+				{357, 2.92, 0.067},
+				{317, 5.849, 11790.629},
+				{284, 1.899, 796.298},
+				{271, 0.315, 10977.079},
+				{243, 0.345, 5486.778},
+				{206, 4.806, 2544.314},
+				{205, 1.869, 5573.143},
+				{202, 2.458, 6069.777},
+				{156, 0.833, 213.299},
+				{132, 3.411, 2942.463},
+				{126, 1.083, 20.775},
+				{115, 0.645, 0.98},
+				{103, 0.636, 4694.003},
+				{102, 0.976, 15720.839},
+				{102, 4.267, 7.114},
+				{99, 6.21, 2146.17},
+				{98, 0.68, 155.42},
+				{86, 5.98, 161000.69},
+				{85, 1.3, 6275.96},
+				{85, 3.67, 71430.7},
+				{80, 1.81, 17260.15},
+				{79, 3.04, 12036.46},
+				{75, 1.76, 5088.63},
+				{74, 3.5, 3154.69},
+				{74, 4.68, 801.82},
+				{70, 0.83, 9437.76},
+				{62, 3.98, 8827.39},
+				{61, 1.82, 7084.9},
+				{57, 2.78, 6286.6},
+				{56, 4.39, 14143.5},
+				{56, 3.47, 6279.55},
+				{52, 0.19, 12139.55},
+				{52, 1.33, 1748.02},
+				{51, 0.28, 5856.48},
+				{49, 0.49, 1194.45},
+				{41, 5.37, 8429.24},
+				{41, 2.4, 19651.05},
+				{39, 6.17, 10447.39},
+				{37, 6.04, 10213.29},
+				{37, 2.57, 1059.38},
+				{36, 1.71, 2352.87},
+				{36, 1.78, 6812.77},
+				{33, 0.59, 17789.85},
+				{30, 0.44, 83996.85},
+				{30, 2.74, 1349.87},
+				{25, 3.16, 4690.48},
+			},
+			{
+				{628331966747, 0, 0},
+				{206059, 2.678235, 6283.07585},
+				{4303, 2.6351, 12566.1517},
+				{425, 1.59, 3.523},
+				{119, 5.796, 26.298},
+				{109, 2.966, 1577.344},
+				{93, 2.59, 18849.23},
+				{72, 1.14, 529.69},
+				{68, 1.87, 398.15},
+				{67, 4.41, 5507.55},
+				{59, 2.89, 5223.69},
+				{56, 2.17, 155.42},
+				{45, 0.4, 796.3},
+				{36, 0.47, 775.52},
+				{29, 2.65, 7.11},
+				{21, 5.34, 0.98},
+				{19, 1.85, 5486.78},
+				{19, 4.97, 213.3},
+				{17, 2.99, 6275.96},
+				{16, 0.03, 2544.31},
+				{16, 1.43, 2146.17},
+				{15, 1.21, 10977.08},
+				{12, 2.83, 1748.02},
+				{12, 3.26, 5088.63},
+				{12, 5.27, 1194.45},
+				{12, 2.08, 4694},
+				{11, 0.77, 553.57},
+				{10, 1.3, 6286.6},
+				{10, 4.24, 1349.87},
+				{9, 2.7, 242.73},
+				{9, 5.64, 951.72},
+				{8, 5.3, 2352.87},
+				{6, 2.65, 9437.76},
+				{6, 4.67, 4690.48},
+			},
+			{
+				{52919, 0, 0},
+				{8720, 1.0721, 6283.0758},
+				{309, 0.867, 12566.152},
+				{27, 0.05, 3.52},
+				{16, 5.19, 26.3},
+				{16, 3.68, 155.42},
+				{10, 0.76, 18849.23},
+				{9, 2.06, 77713.77},
+				{7, 0.83, 775.52},
+				{5, 4.66, 1577.34},
+				{4, 1.03, 7.11},
+				{4, 3.44, 5573.14},
+				{3, 5.14, 796.3},
+				{3, 6.05, 5507.55},
+				{3, 1.19, 242.73},
+				{3, 6.12, 529.69},
+				{3, 0.31, 398.15},
+				{3, 2.28, 553.57},
+				{2, 4.38, 5223.69},
+				{2, 3.75, 0.98},
+			},
+			{
+				{289, 5.844, 6283.076},
+				{35, 0, 0},
+				{17, 5.49, 12566.15},
+				{3, 5.2, 155.42},
+				{1, 4.72, 3.52},
+				{1, 5.3, 18849.23},
+				{1, 5.97, 242.73},
+			},
+			{
+				{114, 3.142, 0},
+				{8, 4.13, 6283.08},
+				{1, 3.84, 12566.15},
+			},
+			{
+				{1, 3.14, 0},
+			},
+		},
+		{
+			{
+				{280, 3.199, 84334.662},
+				{102, 5.422, 5507.553},
+				{80, 3.88, 5223.69},
+				{44, 3.7, 2352.87},
+				{32, 4, 1577.34},
+			},
+			{
+				{9, 3.9, 5507.55},
+				{6, 1.73, 5223.69},
+			},
+		},
+		{
+			{
+				{100013989, 0, 0},
+				{1670700, 3.0984635, 6283.07585},
+				{13956, 3.05525, 12566.1517},
+				{3084, 5.1985, 77713.7715},
+				{1628, 1.1739, 5753.3849},
+				{1576, 2.8469, 7860.4194},
+				{925, 5.453, 11506.77},
+				{542, 4.564, 3930.21},
+				{472, 3.661, 5884.927},
+				{346, 0.964, 5507.553},
+				{329, 5.9, 5223.694},
+				{307, 0.299, 5573.143},
+				{243, 4.273, 11790.629},
+				{212, 5.847, 1577.344},
+				{186, 5.022, 10977.079},
+				{175, 3.012, 18849.228},
+				{110, 5.055, 5486.778},
+				{98, 0.89, 6069.78},
+				{86, 5.69, 15720.84},
+				{86, 1.27, 161000.69},
+				{65, 0.27, 17260.15},
+				{63, 0.92, 529.69},
+				{57, 2.01, 83996.85},
+				{56, 5.24, 71430.7},
+				{49, 3.25, 2544.31},
+				{47, 2.58, 775.52},
+				{45, 5.54, 9437.76},
+				{43, 6.01, 6275.96},
+				{39, 5.36, 4694},
+				{38, 2.39, 8827.39},
+				{37, 0.83, 19651.05},
+				{37, 4.9, 12139.55},
+				{36, 1.67, 12036.46},
+				{35, 1.84, 2942.46},
+				{33, 0.24, 7084.9},
+				{32, 0.18, 5088.63},
+				{32, 1.78, 398.15},
+				{28, 1.21, 6286.6},
+				{28, 1.9, 6279.55},
+				{26, 4.59, 10447.39},
+			},
+			{
+				{103019, 1.10749, 6283.07585},
+				{1721, 1.0644, 12566.1517},
+				{702, 3.142, 0},
+				{32, 1.02, 18849.23},
+				{31, 2.84, 5507.55},
+				{25, 1.32, 5223.69},
+				{18, 1.42, 1577.34},
+				{10, 5.91, 10977.08},
+				{9, 1.42, 6275.96},
+				{9, 0.27, 5486.78},
+			},
+			{
+				{4359, 5.7846, 6283.0758},
+				{124, 5.579, 12566.152},
+				{12, 3.14, 0},
+				{9, 3.63, 77713.77},
+				{6, 1.87, 5573.14},
+				{3, 5.47, 18849.23},
+			},
+			{
+				{145, 4.273, 6283.076},
+				{7, 3.92, 12566.15},
+			},
+			{
+				{4, 2.56, 6283.08},
+			},
+		},
+	},
+	"Jupiter": {
+		{
+			{
+				{59954691, 0, 0},
+				{9695899, 5.0619179, 529.6909651},
+				{573610, 1.444062, 7.113547},
+				{306389, 5.417347, 1059.38193},
+				{97178, 4.14265, 632.78374},
+				{72903, 3.64043, 522.57742},
+				{64264, 3.41145, 103.09277},
+				{39806, 2.29377, 419.48464},
+				{38858, 1.27232, 316.39187},
+				{27965, 1.78455, 536.80451},
+				{13590, 5.77481, 1589.0729},
+				{8769, 3.63, 949.1756},
+				{8246, 3.5823, 206.1855},
+				{7368, 5.081, 735.8765},
+				{6263, 0.025, 213.2991},
+				{6114, 4.5132, 1162.4747},
+				{5305, 4.1863, 1052.2684},
+				{5305, 1.3067, 14.2271},
+				{4905, 1.3208, 110.2063},
+				{4647, 4.6996, 3.9322},
+				{3045, 4.3168, 426.5982},
+				{2610, 1.5667, 846.0828},
+				{2028, 1.0638, 3.1814},
+				{1921, 0.9717, 639.8973},
+				{1765, 2.1415, 1066.4955},
+				{1723, 3.8804, 1265.5675},
+				{1633, 3.582, 515.4639},
+				{1432, 4.2968, 625.6702},
+				{973, 4.098, 95.979},
+				{884, 2.437, 412.371},
+				{733, 6.085, 838.969},
+				{731, 3.806, 1581.959},
+				{709, 1.293, 742.99},
+				{692, 6.134, 2118.764},
+				{614, 4.109, 1478.867},
+				{582, 4.54, 309.278},
+				{495, 3.756, 323.505},
+				{441, 2.958, 454.909},
+				{417, 1.036, 2.448},
+				{390, 4.897, 1692.166},
+				{376, 4.703, 1368.66},
+				{341, 5.715, 533.623},
+				{330, 4.74, 0.048},
+				{262, 1.877, 0.963},
+				{261, 0.82, 380.128},
+				{257, 3.724, 199.072},
+				{244, 5.22, 728.763},
+				{235, 1.227, 909.819},
+				{220, 1.651, 543.918},
+				{207, 1.855, 525.759},
+				{202, 1.807, 1375.774},
+				{197, 5.293, 1155.361},
+				{175, 3.73, 942.062},
+				{175, 3.226, 1898.351},
+				{175, 5.91, 956.289},
+				{158, 4.365, 860.31},
+			},
+			{
+				{52993480757, 0, 0},
+				{489741, 4.220667, 529.690965},
+				{228919, 6.026475, 7.113547},
+				{27655, 4.57266, 1059.38193},
+				{20721, 5.45939, 522.57742},
+				{12106, 0.16986, 536.80451},
+				{6068, 4.4242, 103.0928},
+				{5434, 3.9848, 419.4846},
+				{4238, 5.8901, 14.2271},
+				{2212, 5.2677, 206.1855},
+				{1746, 4.9267, 1589.0729},
+				{1296, 5.5513, 3.1814},
+				{1173, 5.8565, 1052.2684},
+				{1163, 0.5145, 3.9322},
+				{1099, 5.307, 515.4639},
+				{1007, 0.4648, 735.8765},
+				{1004, 3.1504, 426.5982},
+				{848, 5.758, 110.206},
+				{827, 4.803, 213.299},
+				{816, 0.586, 1066.496},
+				{725, 5.518, 639.897},
+				{568, 5.989, 625.67},
+				{474, 4.132, 412.371},
+				{413, 5.737, 95.979},
+				{345, 4.242, 632.784},
+				{336, 3.732, 1162.475},
+				{234, 4.035, 949.176},
+				{234, 6.243, 309.278},
+				{199, 1.505, 838.969},
+				{195, 2.219, 323.505},
+				{187, 6.086, 742.99},
+				{184, 6.28, 543.918},
+				{171, 5.417, 199.072},
+				{131, 0.626, 728.763},
+				{115, 0.68, 846.083},
+				{115, 5.286, 2118.764},
+				{108, 4.493, 956.289},
+				{80, 5.82, 1045.15},
+				{72, 5.34, 942.06},
+				{70, 5.97, 532.87},
+				{67, 5.73, 21.34},
+				{66, 0.13, 526.51},
+				{65, 6.09, 1581.96},
+				{59, 0.59, 1155.36},
+				{58, 0.99, 1596.19},
+				{57, 5.97, 1169.59},
+				{57, 1.41, 533.62},
+				{55, 5.43, 10.29},
+				{52, 5.73, 117.32},
+				{52, 0.23, 1368.66},
+				{50, 6.08, 525.76},
+				{47, 3.63, 1478.87},
+				{47, 0.51, 1265.57},
+				{40, 4.16, 1692.17},
+				{34, 0.1, 302.16},
+				{33, 5.04, 220.41},
+				{32, 5.37, 508.35},
+				{29, 5.42, 1272.68},
+				{29, 3.36, 4.67},
+				{29, 0.76, 88.87},
+				{25, 1.61, 831.86},
+			},
+			{
+				{47234, 4.32148, 7.11355},
+				{38966, 0, 0},
+				{30629, 2.93021, 529.69097},
+				{3189, 1.055, 522.5774},
+				{2729, 4.8455, 536.8045},
+				{2723, 3.4141, 1059.3819},
+				{1721, 4.1873, 14.2271},
+				{383, 5.768, 419.485},
+				{378, 0.76, 515.464},
+				{367, 6.055, 103.093},
+				{337, 3.786, 3.181},
+				{308, 0.694, 206.186},
+				{218, 3.814, 1589.073},
+				{199, 5.34, 1066.495},
+				{197, 2.484, 3.932},
+				{156, 1.406, 1052.268},
+				{146, 3.814, 639.897},
+				{142, 1.634, 426.598},
+				{130, 5.837, 412.371},
+				{117, 1.414, 625.67},
+				{97, 4.03, 110.21},
+				{91, 1.11, 95.98},
+				{87, 2.52, 632.78},
+				{79, 4.64, 543.92},
+				{72, 2.22, 735.88},
+				{58, 0.83, 199.07},
+				{57, 3.12, 213.3},
+				{49, 1.67, 309.28},
+				{40, 4.02, 21.34},
+				{40, 0.62, 323.51},
+				{36, 2.33, 728.76},
+				{29, 3.61, 10.29},
+				{28, 3.24, 838.97},
+				{26, 4.5, 742.99},
+				{26, 2.51, 1162.47},
+				{25, 1.22, 1045.15},
+				{24, 3.01, 956.29},
+				{19, 4.29, 532.87},
+				{18, 0.81, 508.35},
+				{17, 4.2, 2118.76},
+				{17, 1.83, 526.51},
+				{15, 5.81, 1596.19},
+				{15, 0.68, 942.06},
+				{15, 4, 117.32},
+				{14, 5.95, 316.39},
+				{14, 1.8, 302.16},
+				{13, 2.52, 88.87},
+				{13, 4.37, 1169.59},
+				{11, 4.44, 525.76},
+				{10, 1.72, 1581.96},
+				{9, 2.18, 1155.36},
+				{9, 3.29, 242.73},
+			},
+			{
+				{6502, 2.5986, 7.1135},
+				{1357, 1.3464, 529.691},
+				{471, 2.475, 14.227},
+				{417, 3.245, 536.805},
+				{353, 2.974, 522.577},
+				{155, 2.076, 1059.382},
+				{87, 2.51, 515.46},
+				{44, 0, 0},
+				{34, 3.83, 1066.5},
+				{28, 2.45, 206.19},
+				{24, 1.28, 412.37},
+				{23, 2.98, 543.92},
+				{20, 2.1, 639.9},
+				{20, 1.4, 419.48},
+				{19, 1.59, 103.09},
+				{17, 2.3, 21.34},
+				{17, 2.6, 1589.07},
+				{16, 3.15, 625.67},
+				{16, 3.36, 1052.27},
+				{13, 2.76, 95.98},
+				{13, 2.54, 199.07},
+				{13, 6.27, 426.6},
+				{9, 1.76, 10.29},
+				{9, 2.27, 110.21},
+				{7, 3.43, 309.28},
+				{7, 4.04, 728.76},
+				{6, 2.52, 508.35},
+				{5, 2.91, 1045.15},
+			},
+			{
+				{669, 0.853, 7.114},
+				{114, 3.142, 0},
+				{100, 0.743, 14.227},
+				{50, 1.65, 536.8},
+				{44, 5.82, 529.69},
+				{32, 4.86, 522.58},
+				{15, 4.29, 515.46},
+				{9, 0.71, 1059.38},
+				{5, 1.3, 543.92},
+				{4, 2.32, 1066.5},
+				{4, 0.48, 21.34},
+				{3, 3, 412.37},
+				{2, 0.4, 639.9},
+				{2, 4.26, 199.07},
+				{2, 4.91, 625.67},
+				{2, 4.26, 206.19},
+				{1, 5.26, 1052.27},
+				{1, 4.72, 95.98},
+				{1, 1.29, 1589.07},
+			},
+			{
+				{50, 5.26, 7.11},
+				{16, 5.25, 14.23},
+				{4, 0.01, 536.8},
+				{2, 1.1, 522.58},
+				{1, 3.14, 0},
+			},
+		},
+		{
+			{
+				{2268616, 3.558508, 529.6909651},
+				{110090, 0, 0},
+				{109972, 3.908093, 1059.38193},
+				{8101, 3.6051, 522.5774},
+				{6438, 0.3063, 536.8045},
+				{6044, 4.2588, 1589.0729},
+				{1107, 2.9853, 1162.4747},
+				{944, 1.675, 426.598},
+				{942, 2.936, 1052.268},
+				{894, 1.754, 7.114},
+				{836, 5.179, 103.093},
+				{767, 2.155, 632.784},
+				{684, 3.678, 213.299},
+				{629, 0.643, 1066.495},
+				{559, 0.014, 846.083},
+				{532, 2.703, 110.206},
+				{464, 1.173, 949.176},
+				{431, 2.608, 419.485},
+				{351, 4.611, 2118.764},
+				{132, 4.778, 742.99},
+				{123, 3.35, 1692.166},
+				{116, 1.387, 323.505},
+				{115, 5.049, 316.392},
+				{104, 3.701, 515.464},
+				{103, 2.319, 1478.867},
+				{102, 3.153, 1581.959},
+			},
+			{
+				{177352, 5.701665, 529.690965},
+				{3230, 5.7794, 1059.3819},
+				{3081, 5.4746, 522.5774},
+				{2212, 4.7348, 536.8045},
+				{1694, 3.1416, 0},
+				{346, 4.746, 1052.268},
+				{234, 5.189, 1066.495},
+				{196, 6.186, 7.114},
+				{150, 3.927, 1589.073},
+				{114, 3.439, 632.784},
+				{97, 2.91, 949.18},
+				{82, 5.08, 1162.47},
+				{77, 2.51, 103.09},
+				{77, 0.61, 419.48},
+				{74, 5.5, 515.46},
+				{61, 5.45, 213.3},
+				{50, 3.95, 735.88},
+				{46, 0.54, 110.21},
+				{45, 1.9, 846.08},
+				{37, 4.7, 543.92},
+				{36, 6.11, 316.39},
+				{32, 4.92, 1581.96},
+			},
+			{
+				{8094, 1.4632, 529.691},
+				{813, 3.1416, 0},
+				{742, 0.957, 522.577},
+				{399, 2.899, 536.805},
+				{342, 1.447, 1059.382},
+				{74, 0.41, 1052.27},
+				{46, 3.48, 1066.5},
+				{30, 1.93, 1589.07},
+				{29, 0.99, 515.46},
+				{23, 4.27, 7.11},
+				{14, 2.92, 543.92},
+				{12, 5.22, 632.78},
+				{11, 4.88, 949.18},
+				{6, 6.21, 1045.15},
+			},
+			{
+				{252, 3.381, 529.691},
+				{122, 2.733, 522.577},
+				{49, 1.04, 536.8},
+				{11, 2.31, 1052.27},
+				{8, 2.77, 515.46},
+				{7, 4.25, 1059.38},
+				{6, 1.78, 1066.5},
+				{4, 1.13, 543.92},
+				{3, 3.14, 0},
+			},
+			{
+				{15, 4.53, 522.58},
+				{5, 4.47, 529.69},
+				{4, 5.44, 536.8},
+				{3, 0, 0},
+				{2, 4.52, 515.46},
+				{1, 4.2, 1052.27},
+			},
+			{
+				{1, 0.09, 522.58},
+			},
+		},
+		{
+			{
+				{520887429, 0, 0},
+				{25209327, 3.4910864, 529.69096509},
+				{610600, 3.841154, 1059.38193},
+				{282029, 2.574199, 632.783739},
+				{187647, 2.075904, 522.577418},
+				{86793, 0.71001, 419.48464},
+				{72063, 0.21466, 536.80451},
+				{65517, 5.97996, 316.39187},
+				{30135, 2.16132, 949.17561},
+				{29135, 1.67759, 103.09277},
+				{23947, 0.27458, 7.11355},
+				{23453, 3.54023, 735.87651},
+				{22284, 4.19363, 1589.0729},
+				{13033, 2.96043, 1162.4747},
+				{12749, 2.7155, 1052.26838},
+				{9703, 1.9067, 206.1855},
+				{9161, 4.4135, 213.2991},
+				{7895, 2.4791, 426.5982},
+				{7058, 2.1818, 1265.5675},
+				{6138, 6.2642, 846.0828},
+				{5477, 5.6573, 639.8973},
+				{4170, 2.0161, 515.4639},
+				{4137, 2.7222, 625.6702},
+				{3503, 0.5653, 1066.4955},
+				{2617, 2.0099, 1581.9593},
+				{2500, 4.5518, 838.9693},
+				{2128, 6.1275, 742.9901},
+				{1912, 0.8562, 412.3711},
+				{1611, 3.0887, 1368.6603},
+				{1479, 2.6803, 1478.8666},
+				{1231, 1.8904, 323.5054},
+				{1217, 1.8017, 110.2063},
+				{1015, 1.3867, 454.9094},
+				{999, 2.872, 309.278},
+				{961, 4.549, 2118.764},
+				{886, 4.148, 533.623},
+				{821, 1.593, 1898.351},
+				{812, 5.941, 909.819},
+				{777, 3.677, 728.763},
+				{727, 3.988, 1155.361},
+				{655, 2.791, 1685.052},
+				{654, 3.382, 1692.166},
+				{621, 4.823, 956.289},
+				{615, 2.276, 942.062},
+				{562, 0.081, 543.918},
+				{542, 0.284, 525.759},
+			},
+			{
+				{1271802, 2.6493751, 529.6909651},
+				{61662, 3.00076, 1059.38193},
+				{53444, 3.89718, 522.57742},
+				{41390, 0, 0},
+				{31185, 4.88277, 536.80451},
+				{11847, 2.4133, 419.48464},
+				{9166, 4.7598, 7.1135},
+				{3404, 3.3469, 1589.0729},
+				{3203, 5.2108, 735.8765},
+				{3176, 2.793, 103.0928},
+				{2806, 3.7422, 515.4639},
+				{2677, 4.3305, 1052.2684},
+				{2600, 3.6344, 206.1855},
+				{2412, 1.4695, 426.5982},
+				{2101, 3.9276, 639.8973},
+				{1646, 4.4163, 1066.4955},
+				{1641, 4.4163, 625.6702},
+				{1050, 3.1611, 213.2991},
+				{1025, 2.5543, 412.3711},
+				{806, 2.678, 632.784},
+				{741, 2.171, 1162.475},
+				{677, 6.25, 838.969},
+				{567, 4.577, 742.99},
+				{485, 2.469, 949.176},
+				{469, 4.71, 543.918},
+				{445, 0.403, 323.505},
+				{416, 5.368, 728.763},
+				{402, 4.605, 309.278},
+				{347, 4.681, 14.227},
+				{338, 3.168, 956.289},
+				{261, 5.343, 846.083},
+				{247, 3.923, 942.062},
+				{220, 4.842, 1368.66},
+				{203, 5.6, 1155.361},
+				{200, 4.439, 1045.155},
+				{197, 3.706, 2118.764},
+				{196, 3.759, 199.072},
+				{184, 4.265, 95.979},
+				{180, 4.402, 532.872},
+				{170, 4.846, 526.51},
+				{146, 6.13, 533.623},
+				{133, 1.322, 110.206},
+				{132, 4.512, 525.759},
+			},
+			{
+				{79645, 1.35866, 529.69097},
+				{8252, 5.7777, 522.5774},
+				{7030, 3.2748, 536.8045},
+				{5314, 1.8384, 1059.3819},
+				{1861, 2.9768, 7.1135},
+				{964, 5.48, 515.464},
+				{836, 4.199, 419.485},
+				{498, 3.142, 0},
+				{427, 2.228, 639.897},
+				{406, 3.783, 1066.496},
+				{377, 2.242, 1589.073},
+				{363, 5.368, 206.186},
+				{342, 6.099, 1052.268},
+				{339, 6.127, 625.67},
+				{333, 0.003, 426.598},
+				{280, 4.262, 412.371},
+				{257, 0.963, 632.784},
+				{230, 0.705, 735.877},
+				{201, 3.069, 543.918},
+				{200, 4.429, 103.093},
+				{139, 2.932, 14.227},
+				{114, 0.787, 728.763},
+				{95, 1.7, 838.97},
+				{86, 5.14, 323.51},
+				{83, 0.06, 309.28},
+				{80, 2.98, 742.99},
+				{75, 2.04, 956.29},
+				{72, 0.95, 1162.47},
+			},
+			{
+				{3519, 6.058, 529.691},
+				{1073, 1.6732, 536.8045},
+				{916, 1.413, 522.577},
+				{342, 0.523, 1059.382},
+				{255, 1.196, 7.114},
+				{222, 0.952, 515.464},
+				{90, 3.14, 0},
+				{69, 2.27, 1066.5},
+				{58, 1.41, 543.92},
+				{58, 0.53, 639.9},
+				{51, 5.98, 412.37},
+				{47, 1.58, 625.67},
+				{43, 6.12, 419.48},
+				{37, 1.18, 14.23},
+				{34, 1.67, 1052.27},
+				{34, 0.85, 206.19},
+				{31, 1.04, 1589.07},
+				{30, 4.63, 426.6},
+				{21, 2.5, 728.76},
+				{15, 0.89, 199.07},
+				{14, 0.96, 508.35},
+				{13, 1.5, 1045.15},
+				{12, 2.61, 735.88},
+				{12, 3.56, 323.51},
+				{11, 1.79, 309.28},
+				{11, 6.28, 956.29},
+				{10, 6.26, 103.09},
+				{9, 3.45, 838.97},
+			},
+			{
+				{129, 0.084, 536.805},
+				{113, 4.249, 529.691},
+				{83, 3.3, 522.58},
+				{38, 2.73, 515.46},
+				{27, 5.69, 7.11},
+				{18, 5.4, 1059.38},
+			},
+		},
+	},
+	"Saturn": {
+		{
+			{
+				{87401354, 0, 0},
+				{11107660, 3.9620509, 213.29909544},
+				{1414151, 4.5858152, 7.113547},
+				{398379, 0.52112, 206.185548},
+				{350769, 3.303299, 426.598191},
+				{206816, 0.246584, 103.092774},
+				{79271, 3.84007, 220.41264},
+				{23990, 4.66977, 110.20632},
+				{16574, 0.43719, 419.48464},
+				{15820, 0.93809, 632.78374},
+				{15054, 2.7167, 639.89729},
+				{14907, 5.76903, 316.39187},
+				{14610, 1.56519, 3.93215},
+				{13160, 4.44891, 14.22709},
+				{13005, 5.98119, 11.0457},
+				{10725, 3.1294, 202.2534},
+				{6126, 1.7633, 277.035},
+				{5863, 0.2366, 529.691},
+				{5228, 4.2078, 3.1814},
+				{5020, 3.1779, 433.7117},
+				{4593, 0.6198, 199.072},
+				{4006, 2.2448, 63.7359},
+				{3874, 3.2228, 138.5175},
+				{3269, 0.7749, 949.1756},
+				{2954, 0.9828, 95.9792},
+				{2461, 2.0316, 735.8765},
+				{1758, 3.2658, 522.5774},
+				{1640, 5.505, 846.0828},
+				{1581, 4.3727, 309.2783},
+				{1391, 4.0233, 323.5054},
+				{1124, 2.8373, 415.5525},
+				{1087, 4.1834, 2.4477},
+				{1017, 3.717, 227.5262},
+				{957, 0.507, 1265.567},
+				{853, 3.421, 175.166},
+				{849, 3.191, 209.367},
+				{789, 5.007, 0.963},
+				{749, 2.144, 853.196},
+				{744, 5.253, 224.345},
+				{687, 1.747, 1052.268},
+				{654, 1.599, 0.048},
+				{634, 2.299, 412.371},
+				{625, 0.97, 210.118},
+				{580, 3.093, 74.782},
+				{546, 2.127, 350.332},
+				{543, 1.518, 9.561},
+				{530, 4.449, 117.32},
+				{478, 2.965, 137.033},
+				{474, 5.475, 742.99},
+				{452, 1.044, 490.334},
+				{449, 1.29, 127.472},
+				{372, 2.278, 217.231},
+				{355, 3.213, 838.969},
+				{347, 1.539, 340.771},
+				{343, 0.883, 942.062},
+				{330, 0.998, 1581.959},
+			},
+			{
+				{21354295596, 0, 0},
+				{1296855, 1.8282054, 213.2990954},
+				{564348, 2.885001, 7.113547},
+				{107679, 2.277699, 206.185548},
+				{98323, 1.0807, 426.59819},
+				{40255, 2.04128, 220.41264},
+				{19942, 1.27955, 103.09277},
+				{10512, 2.7488, 14.22709},
+				{6939, 0.4049, 639.8973},
+				{4803, 2.4419, 419.4846},
+				{4056, 2.9217, 110.2063},
+				{3769, 3.6497, 3.9322},
+				{3385, 2.4169, 3.1814},
+				{3302, 1.2626, 433.7117},
+				{3071, 2.3274, 199.072},
+				{1953, 3.5639, 11.0457},
+				{1249, 2.628, 95.9792},
+				{922, 1.961, 227.526},
+				{706, 4.417, 529.691},
+				{650, 6.174, 202.253},
+				{628, 6.111, 309.278},
+				{487, 6.04, 853.196},
+				{479, 4.988, 522.577},
+				{468, 4.617, 63.736},
+				{417, 2.117, 323.505},
+				{408, 1.299, 209.367},
+				{352, 2.317, 632.784},
+				{344, 3.959, 412.371},
+				{340, 3.634, 316.392},
+				{336, 3.772, 735.877},
+				{332, 2.861, 210.118},
+				{289, 2.733, 117.32},
+				{281, 5.744, 2.448},
+				{266, 0.543, 647.011},
+				{230, 1.644, 216.48},
+				{192, 2.965, 224.345},
+				{173, 4.077, 846.083},
+				{167, 2.597, 21.341},
+				{136, 2.286, 10.295},
+				{131, 3.441, 742.99},
+				{128, 4.095, 217.231},
+				{109, 6.161, 415.552},
+				{98, 4.73, 838.97},
+				{94, 3.48, 1052.27},
+				{92, 3.95, 88.87},
+				{87, 1.22, 440.83},
+				{83, 3.11, 625.67},
+				{78, 6.24, 302.16},
+				{67, 0.29, 4.67},
+				{66, 5.65, 9.56},
+				{62, 4.29, 127.47},
+				{62, 1.83, 195.14},
+				{58, 2.48, 191.96},
+				{57, 5.02, 137.03},
+				{55, 0.28, 74.78},
+				{54, 5.13, 490.33},
+				{51, 1.46, 536.8},
+				{47, 1.18, 149.56},
+				{47, 5.15, 515.46},
+				{46, 2.23, 956.29},
+				{44, 2.71, 5.42},
+				{40, 0.41, 269.92},
+				{40, 3.89, 728.76},
+				{38, 0.65, 422.67},
+				{38, 2.53, 12.53},
+				{37, 3.78, 2.92},
+				{35, 6.08, 5.63},
+				{34, 3.21, 1368.66},
+				{33, 4.64, 277.03},
+				{33, 5.43, 1066.5},
+				{33, 0.3, 351.82},
+				{32, 4.39, 1155.36},
+				{31, 2.43, 52.69},
+			},
+			{
+				{116441, 1.179879, 7.113547},
+				{91921, 0.07425, 213.2991},
+				{90592, 0, 0},
+				{15277, 4.06492, 206.18555},
+				{10631, 0.25778, 220.41264},
+				{10605, 5.40964, 426.59819},
+				{4265, 1.046, 14.2271},
+				{1216, 2.9186, 103.0928},
+				{1165, 4.6094, 639.8973},
+				{1082, 5.6913, 433.7117},
+				{1045, 4.0421, 199.072},
+				{1020, 0.6337, 3.1814},
+				{634, 4.388, 419.485},
+				{549, 5.573, 3.932},
+				{457, 1.268, 110.206},
+				{425, 0.209, 227.526},
+				{274, 4.288, 95.979},
+				{162, 1.381, 11.046},
+				{129, 1.566, 309.278},
+				{117, 3.881, 853.196},
+				{105, 4.9, 647.011},
+				{101, 0.893, 21.341},
+				{96, 2.91, 316.39},
+				{95, 5.63, 412.37},
+				{85, 5.73, 209.37},
+				{83, 6.05, 216.48},
+				{82, 1.02, 117.32},
+				{75, 4.76, 210.12},
+				{67, 0.46, 522.58},
+				{66, 0.48, 10.29},
+				{64, 0.35, 323.51},
+				{61, 4.88, 632.78},
+				{53, 2.75, 529.69},
+				{46, 5.69, 440.83},
+				{45, 1.67, 202.25},
+				{42, 5.71, 88.87},
+				{32, 0.07, 63.74},
+				{32, 1.67, 302.16},
+				{31, 4.16, 191.96},
+				{27, 0.83, 224.34},
+				{25, 5.66, 735.88},
+				{20, 5.94, 217.23},
+				{18, 4.9, 625.67},
+				{17, 1.63, 742.99},
+				{16, 0.58, 515.46},
+				{14, 0.21, 838.97},
+				{14, 3.76, 195.14},
+				{12, 4.72, 203},
+				{12, 0.13, 234.64},
+				{12, 3.12, 846.08},
+				{11, 5.92, 536.8},
+				{11, 5.6, 728.76},
+				{11, 3.2, 1066.5},
+				{10, 4.99, 422.67},
+				{10, 0.26, 330.62},
+				{10, 4.15, 860.31},
+				{9, 0.46, 956.29},
+				{8, 2.14, 269.92},
+				{8, 5.25, 429.78},
+				{8, 4.03, 9.56},
+				{7, 5.4, 1052.27},
+				{6, 4.46, 284.15},
+				{6, 5.93, 405.26},
+			},
+			{
+				{16039, 5.73945, 7.11355},
+				{4250, 4.5854, 213.2991},
+				{1907, 4.7608, 220.4126},
+				{1466, 5.9133, 206.1855},
+				{1162, 5.6197, 14.2271},
+				{1067, 3.6082, 426.5982},
+				{239, 3.861, 433.712},
+				{237, 5.768, 199.072},
+				{166, 5.116, 3.181},
+				{151, 2.736, 639.897},
+				{131, 4.743, 227.526},
+				{63, 0.23, 419.48},
+				{62, 4.74, 103.09},
+				{40, 5.47, 21.34},
+				{40, 5.96, 95.98},
+				{39, 5.83, 110.21},
+				{28, 3.01, 647.01},
+				{25, 0.99, 3.93},
+				{19, 1.92, 853.2},
+				{18, 4.97, 10.29},
+				{18, 1.03, 412.37},
+				{18, 4.2, 216.48},
+				{18, 3.32, 309.28},
+				{16, 3.9, 440.83},
+				{16, 5.62, 117.32},
+				{13, 1.18, 88.87},
+				{11, 5.58, 11.05},
+				{11, 5.93, 191.96},
+				{10, 3.95, 209.37},
+				{9, 3.39, 302.16},
+				{8, 4.88, 323.51},
+				{7, 0.38, 632.78},
+				{6, 2.25, 522.58},
+				{6, 1.06, 210.12},
+				{5, 4.64, 234.64},
+				{4, 3.14, 0},
+				{4, 2.31, 515.46},
+				{3, 2.2, 860.31},
+				{3, 0.59, 529.69},
+				{3, 4.93, 224.34},
+				{3, 0.42, 625.67},
+				{2, 4.77, 330.62},
+				{2, 3.35, 429.78},
+				{2, 3.2, 202.25},
+				{2, 1.19, 1066.5},
+				{2, 1.35, 405.26},
+				{2, 4.16, 223.59},
+				{2, 3.07, 654.12},
+			},
+			{
+				{1662, 3.9983, 7.1135},
+				{257, 2.984, 220.413},
+				{236, 3.902, 14.227},
+				{149, 2.741, 213.299},
+				{114, 3.142, 0},
+				{110, 1.515, 206.186},
+				{68, 1.72, 426.6},
+				{40, 2.05, 433.71},
+				{38, 1.24, 199.07},
+				{31, 3.01, 227.53},
+				{15, 0.83, 639.9},
+				{9, 3.71, 21.34},
+				{6, 2.42, 419.48},
+				{6, 1.16, 647.01},
+				{4, 1.45, 95.98},
+				{4, 2.12, 440.83},
+				{3, 4.09, 110.21},
+				{3, 2.77, 412.37},
+				{3, 3.01, 88.87},
+				{3, 0, 853.2},
+				{3, 0.39, 103.09},
+				{2, 3.78, 117.32},
+				{2, 2.83, 234.64},
+				{2, 5.08, 309.28},
+				{2, 2.24, 216.48},
+				{2, 5.19, 302.16},
+				{1, 1.55, 191.96},
+			},
+			{
+				{124, 2.259, 7.114},
+				{34, 2.16, 14.23},
+				{28, 1.2, 220.41},
+				{6, 1.22, 227.53},
+				{5, 0.24, 433.71},
+				{4, 6.23, 426.6},
+				{3, 2.97, 199.07},
+				{3, 4.29, 206.19},
+				{2, 6.25, 213.3},
+				{1, 5.28, 639.9},
+				{1, 0.24, 440.83},
+				{1, 3.14, 0},
+			},
+		},
+		{
+			{
+				{4330678, 3.6028443, 213.2990954},
+				{240348, 2.852385, 426.598191},
+				{84746, 0, 0},
+				{34116, 0.57297, 206.18555},
+				{30863, 3.48442, 220.41264},
+				{14734, 2.11847, 639.89729},
+				{9917, 5.79, 419.4846},
+				{6994, 4.736, 7.1135},
+				{4808, 5.4331, 316.3919},
+				{4788, 4.9651, 110.2063},
+				{3432, 2.7326, 433.7117},
+				{1506, 6.013, 103.0928},
+				{1060, 5.631, 529.691},
+				{969, 5.204, 632.784},
+				{942, 1.396, 853.196},
+				{708, 3.803, 323.505},
+				{552, 5.131, 202.253},
+				{400, 3.359, 227.526},
+				{319, 3.626, 209.367},
+				{316, 1.997, 647.011},
+				{314, 0.465, 217.231},
+				{284, 4.886, 224.345},
+				{236, 2.139, 11.046},
+				{215, 5.95, 846.083},
+				{209, 2.12, 415.552},
+				{207, 0.73, 199.072},
+				{179, 2.954, 63.736},
+				{141, 0.644, 490.334},
+				{139, 4.595, 14.227},
+				{139, 1.998, 735.877},
+				{135, 5.245, 742.99},
+				{122, 3.115, 522.577},
+				{116, 3.109, 216.48},
+				{114, 0.963, 210.118},
+			},
+			{
+				{397555, 5.3329, 213.299095},
+				{49479, 3.14159, 0},
+				{18572, 6.09919, 426.59819},
+				{14801, 2.30586, 206.18555},
+				{9644, 1.6967, 220.4126},
+				{3757, 1.2543, 419.4846},
+				{2717, 5.9117, 639.8973},
+				{1455, 0.8516, 433.7117},
+				{1291, 2.9177, 7.1135},
+				{853, 0.436, 316.392},
+				{298, 0.919, 632.784},
+				{292, 5.316, 853.196},
+				{284, 1.619, 227.526},
+				{275, 3.889, 103.093},
+				{172, 0.052, 647.011},
+				{166, 2.444, 199.072},
+				{158, 5.209, 110.206},
+				{128, 1.207, 529.691},
+				{110, 2.457, 217.231},
+				{82, 2.76, 210.12},
+				{81, 2.86, 14.23},
+				{69, 1.66, 202.25},
+				{65, 1.26, 216.48},
+				{61, 1.25, 209.37},
+				{59, 1.82, 323.51},
+				{46, 0.82, 440.83},
+				{36, 1.82, 224.34},
+				{34, 2.84, 117.32},
+				{33, 1.31, 412.37},
+				{32, 1.19, 846.08},
+				{27, 4.65, 1066.5},
+				{27, 4.44, 11.05},
+			},
+			{
+				{20630, 0.50482, 213.2991},
+				{3720, 3.9983, 206.1855},
+				{1627, 6.1819, 220.4126},
+				{1346, 0, 0},
+				{706, 3.039, 419.485},
+				{365, 5.099, 426.598},
+				{330, 5.279, 433.712},
+				{219, 3.828, 639.897},
+				{139, 1.043, 7.114},
+				{104, 6.157, 227.526},
+				{93, 1.98, 316.39},
+				{71, 4.15, 199.07},
+				{52, 2.88, 632.78},
+				{49, 4.43, 647.01},
+				{41, 3.16, 853.2},
+				{29, 4.53, 210.12},
+				{24, 1.12, 14.23},
+				{21, 4.35, 217.23},
+				{20, 5.31, 440.83},
+				{18, 0.85, 110.21},
+				{17, 5.68, 216.48},
+				{16, 4.26, 103.09},
+				{14, 3, 412.37},
+				{12, 2.53, 529.69},
+				{8, 3.32, 202.25},
+				{7, 5.56, 209.37},
+				{7, 0.29, 323.51},
+				{6, 1.16, 117.32},
+				{6, 3.61, 860.31},
+			},
+			{
+				{666, 1.99, 213.299},
+				{632, 5.698, 206.186},
+				{398, 0, 0},
+				{188, 4.338, 220.413},
+				{92, 4.84, 419.48},
+				{52, 3.42, 433.71},
+				{42, 2.38, 426.6},
+				{26, 4.4, 227.53},
+				{21, 5.85, 199.07},
+				{18, 1.99, 639.9},
+				{11, 5.37, 7.11},
+				{10, 2.55, 647.01},
+				{7, 3.46, 316.39},
+				{6, 4.8, 632.78},
+				{6, 0.02, 210.12},
+				{6, 3.52, 440.83},
+				{5, 5.64, 14.23},
+				{5, 1.22, 853.2},
+				{4, 4.71, 412.37},
+				{3, 0.63, 103.09},
+				{2, 3.72, 216.48},
+			},
+			{
+				{80, 1.12, 206.19},
+				{32, 3.12, 213.3},
+				{17, 2.48, 220.41},
+				{12, 3.14, 0},
+				{9, 0.38, 419.48},
+				{6, 1.56, 433.71},
+				{5, 2.63, 227.53},
+				{5, 1.28, 199.07},
+				{1, 1.43, 426.6},
+				{1, 0.67, 647.01},
+				{1, 1.72, 440.83},
+				{1, 6.18, 639.9},
+			},
+			{
+				{8, 2.82, 206.19},
+				{1, 0.51, 220.41},
+			},
+		},
+		{
+			{
+				{955758136, 0, 0},
+				{52921382, 2.3922622, 213.29909544},
+				{1873680, 5.2354961, 206.1855484},
+				{1464664, 1.6476305, 426.5981909},
+				{821891, 5.9352, 316.39187},
+				{547507, 5.015326, 103.092774},
+				{371684, 2.271148, 220.412642},
+				{361778, 3.139043, 7.113547},
+				{140618, 5.704067, 632.783739},
+				{108975, 3.293136, 110.206321},
+				{69007, 5.941, 419.48464},
+				{61053, 0.94038, 639.89729},
+				{48913, 1.55733, 202.2534},
+				{34144, 0.19519, 277.03499},
+				{32402, 5.47085, 949.17561},
+				{20937, 0.46349, 735.87651},
+				{20839, 1.52103, 433.71174},
+				{20747, 5.33256, 199.072},
+				{15298, 3.05944, 529.69097},
+				{14296, 2.60434, 323.50542},
+				{12884, 1.64892, 138.5175},
+				{11993, 5.98051, 846.08283},
+				{11380, 1.73106, 522.57742},
+				{9796, 5.2048, 1265.5675},
+				{7753, 5.8519, 95.9792},
+				{6771, 3.0043, 14.2271},
+				{6466, 0.1773, 1052.2684},
+				{5850, 1.4552, 415.5525},
+				{5307, 0.5974, 63.7359},
+				{4696, 2.1492, 227.5262},
+				{4044, 1.6401, 209.3669},
+				{3688, 0.7802, 412.3711},
+				{3461, 1.8509, 175.1661},
+				{3420, 4.9455, 1581.9593},
+				{3401, 0.5539, 350.3321},
+				{3376, 3.6953, 224.3448},
+				{2976, 5.6847, 210.1177},
+				{2885, 1.3876, 838.9693},
+				{2881, 0.1796, 853.1964},
+				{2508, 3.5385, 742.9901},
+				{2448, 6.1841, 1368.6603},
+				{2406, 2.9656, 117.3199},
+				{2174, 0.0151, 340.7709},
+				{2024, 5.0541, 11.0457},
+			},
+			{
+				{6182981, 0.2584352, 213.2990954},
+				{506578, 0.711147, 206.185548},
+				{341394, 5.796358, 426.598191},
+				{188491, 0.472157, 220.412642},
+				{186262, 3.141593, 0},
+				{143891, 1.407449, 7.113547},
+				{49621, 6.01744, 103.09277},
+				{20928, 5.09246, 639.89729},
+				{19953, 1.1756, 419.48464},
+				{18840, 1.6082, 110.20632},
+				{13877, 0.75886, 199.072},
+				{12893, 5.9433, 433.71174},
+				{5397, 1.2885, 14.2271},
+				{4869, 0.8679, 323.5054},
+				{4247, 0.393, 227.5262},
+				{3252, 1.2585, 95.9792},
+				{3081, 3.4366, 522.5774},
+				{2909, 4.6068, 202.2534},
+				{2856, 2.1673, 735.8765},
+				{1988, 2.4505, 412.3711},
+				{1941, 6.0239, 209.3669},
+				{1581, 1.2919, 210.1177},
+				{1340, 4.308, 853.1964},
+				{1316, 1.253, 117.3199},
+				{1203, 1.8665, 316.3919},
+				{1091, 0.0753, 216.4805},
+				{966, 0.48, 632.784},
+				{954, 5.152, 647.011},
+				{898, 0.983, 529.691},
+				{882, 1.885, 1052.268},
+				{874, 1.402, 224.345},
+				{785, 3.064, 838.969},
+				{740, 1.382, 625.67},
+				{658, 4.144, 309.278},
+				{650, 1.725, 742.99},
+				{613, 3.033, 63.736},
+				{599, 2.549, 217.231},
+				{503, 2.13, 3.932},
+			},
+			{
+				{436902, 4.786717, 213.299095},
+				{71923, 2.5007, 206.18555},
+				{49767, 4.97168, 220.41264},
+				{43221, 3.8694, 426.59819},
+				{29646, 5.9631, 7.11355},
+				{4721, 2.4753, 199.072},
+				{4142, 4.1067, 433.7117},
+				{3789, 3.0977, 639.8973},
+				{2964, 1.3721, 103.0928},
+				{2556, 2.8507, 419.4846},
+				{2327, 0, 0},
+				{2208, 6.2759, 110.2063},
+				{2188, 5.8555, 14.2271},
+				{1957, 4.9245, 227.5262},
+				{924, 5.464, 323.505},
+				{706, 2.971, 95.979},
+				{546, 4.129, 412.371},
+				{431, 5.178, 522.577},
+				{405, 4.173, 209.367},
+				{391, 4.481, 216.48},
+				{374, 5.834, 117.32},
+				{361, 3.277, 647.011},
+				{356, 3.192, 210.118},
+				{326, 2.269, 853.196},
+				{207, 4.022, 735.877},
+				{204, 0.088, 202.253},
+				{180, 3.597, 632.784},
+				{178, 4.097, 440.825},
+				{154, 3.135, 625.67},
+				{148, 0.136, 302.165},
+				{133, 2.594, 191.958},
+				{132, 5.933, 309.278},
+			},
+			{
+				{20315, 3.02187, 213.2991},
+				{8924, 3.1914, 220.4126},
+				{6909, 4.3517, 206.1855},
+				{4087, 4.2241, 7.1135},
+				{3879, 2.0106, 426.5982},
+				{1071, 4.2036, 199.072},
+				{907, 2.283, 433.712},
+				{606, 3.175, 227.526},
+				{597, 4.135, 14.227},
+				{483, 1.173, 639.897},
+				{393, 0, 0},
+				{229, 4.698, 419.485},
+				{188, 4.59, 110.206},
+				{150, 3.202, 103.093},
+				{121, 3.768, 323.505},
+				{102, 4.71, 95.979},
+				{101, 5.819, 412.371},
+				{93, 1.44, 647.01},
+				{84, 2.63, 216.48},
+				{73, 4.15, 117.32},
+				{62, 2.31, 440.83},
+				{55, 0.31, 853.2},
+				{50, 2.39, 209.37},
+				{45, 4.37, 191.96},
+				{41, 0.69, 522.58},
+				{40, 1.84, 302.16},
+				{38, 5.94, 88.87},
+				{32, 4.01, 21.34},
+			},
+			{
+				{1202, 1.415, 220.4126},
+				{708, 1.162, 213.299},
+				{516, 6.24, 206.186},
+				{427, 2.469, 7.114},
+				{268, 0.187, 426.598},
+				{170, 5.959, 199.072},
+				{150, 0.48, 433.712},
+				{145, 1.442, 227.526},
+				{121, 2.405, 14.227},
+				{47, 5.57, 639.9},
+				{19, 5.86, 647.01},
+				{17, 0.53, 440.83},
+				{16, 2.9, 110.21},
+				{15, 0.3, 419.48},
+				{14, 1.3, 412.37},
+				{13, 2.09, 323.51},
+				{11, 0.22, 95.98},
+				{11, 2.46, 117.32},
+				{10, 3.14, 0},
+				{9, 1.56, 88.87},
+				{9, 2.28, 21.34},
+				{9, 0.68, 216.48},
+				{8, 1.27, 234.64},
+			},
+			{
+				{129, 5.913, 220.413},
+				{32, 0.69, 7.11},
+				{27, 5.91, 227.53},
+				{20, 4.95, 433.71},
+				{20, 0.67, 14.23},
+				{14, 2.67, 206.19},
+				{14, 1.46, 199.07},
+				{13, 4.59, 426.6},
+				{7, 4.63, 213.3},
+				{5, 3.61, 639.9},
+				{4, 4.9, 440.83},
+				{3, 4.07, 647.01},
+				{3, 4.66, 191.96},
+				{3, 0.49, 323.51},
+				{3, 3.18, 419.48},
+				{2, 3.7, 88.87},
+				{2, 3.32, 95.98},
+				{2, 0.56, 117.32},
+			},
+		},
+	},
+}
@@ -2,11 +2,16 @@ package planets
 
 import (
 	"fmt"
+	"go-astronomy/internal/coords"
 	datetime "go-astronomy/internal/dateTime"
 	"go-astronomy/internal/macros"
+	vecmat "go-astronomy/internal/vecMat"
 	"math"
 )
 
+// Time in days light takes to cross one AU
+const LightTimeDaysPerAU = 0.0057755183
+
 func CalculateCoordinatesOfPlanet(day float64, month, year int, planetName string, epochDay float64, epochMonth, epochYear int) (raHrs, raMins int, raSecs float64, decDeg, decMin int, decSec float64) {
 	daysSinceYearStart := datetime.CalculateDayNumber(day, month, year)
 	daysSinceEpoch := macros.DaysElapsedSinceEpoch(epochYear, year)
@@ -35,7 +40,7 @@ func CalculateCoordinatesOfPlanet(day float64, month, year int, planetName strin
 	si := macros.ConvertRadianceToDegree(math.Asin(math.Sin(macros.ConvertDegreesToRadiance(Lp-planetValues["Node"].(float64))) * (math.Sin(macros.ConvertDegreesToRadiance(planetValues["Incl"].(float64))))))
 	y := math.Sin(macros.ConvertDegreesToRadiance(Lp-planetValues["Node"].(float64))) * (math.Cos(macros.ConvertDegreesToRadiance(planetValues["Incl"].(float64))))
 	x := math.Cos(macros.ConvertDegreesToRadiance(Lp - planetValues["Node"].(float64)))
-	tanInv := macros.AdjustAngleInQuadrant(x, y, macros.ConvertRadianceToDegree(math.Atan(y/x)))
+	tanInv := macros.ConvertRadianceToDegree(math.Atan2(y, x))
 	ldash := tanInv + planetValues["Node"].(float64)
 	rdash := r * math.Cos(macros.ConvertDegreesToRadiance(si))
 	// fmt.Printf("\nsi : %f\ny : %f\nx : %f\ntanInv : %f\nldash : %f\nrdash : %f\n", si, y, x, tanInv, ldash, rdash)
@@ -120,3 +125,97 @@ func CalculatePerturbationsInPlanetsOrbit(day float64, month, year int, planetNa
 	fmt.Printf("\njulianDate : %f\nT : %f\nA : %f\nP : %f\nQ : %f\nV : %f\ndeltaL : %f\nMp : %f\nVp : %f\nLp : %f\n", julianDate, T, A, P, Q, V, deltaL, Mp, Vp, Lp)
 	return raHrs, raMins, raSecs, decDeg, decMin, decSec
 }
+
+func calculateOrbitalElement(coefficients [4]float64, T float64) float64 {
+	return coefficients[0] + (T * (coefficients[1] + (T * (coefficients[2] + (T * coefficients[3])))))
+}
+
+func calculatePeriodicPerturbations(planetName string, T float64) float64 {
+	// Periodic perturbations of the mean longitude in decimal degrees from the largest terms of VSOP87
+	perturbation := 0.0
+	for _, term := range LongitudePerturbations[planetName] {
+		perturbation += term[0] * math.Cos(term[1]+(term[2]*T/10))
+	}
+	return macros.ConvertRadianceToDegree(perturbation)
+}
+
+func calculatePeriodicTermsSeries(series [][][3]float64, tau float64) float64 {
+	// Sum of a VSOP87 series in powers of tau, in radians or AU
+	sum := 0.0
+	for power := len(series) - 1; power >= 0; power-- {
+		terms := 0.0
+		for _, term := range series[power] {
+			terms += term[0] * math.Cos(term[1]+(term[2]*tau))
+		}
+		sum = (sum * tau) + terms
+	}
+	return sum * 1e-8
+}
+
+func CalculateHeliocentricEclipticCoordinatesOfPlanet(julianEphemerisDate float64, planetName string) (longitude, latitude, radiusAU float64) {
+	// Heliocentric ecliptic coordinates referred to the mean equinox of date from the truncated VSOP87 of
+	// PeriodicTerms where available, otherwise from the elements of Meeus table 31.A
+	if planetName == "Sun" {
+		return 0, 0, 0
+	}
+	T := (julianEphemerisDate - 2451545.0) / 36525.0
+	if terms, ok := PeriodicTerms[planetName]; ok {
+		longitude = macros.AdjustAngleRange(math.Mod(macros.ConvertRadianceToDegree(calculatePeriodicTermsSeries(terms[0], T/10)), 360), 0, 360)
+		latitude = macros.ConvertRadianceToDegree(calculatePeriodicTermsSeries(terms[1], T/10))
+		return longitude, latitude, calculatePeriodicTermsSeries(terms[2], T/10)
+	}
+	elements := OrbitalElements[planetName]
+	L := calculateOrbitalElement(elements[0], T) + calculatePeriodicPerturbations(planetName, T)
+	a := calculateOrbitalElement(elements[1], T)
+	e := calculateOrbitalElement(elements[2], T)
+	i := macros.ConvertDegreesToRadiance(calculateOrbitalElement(elements[3], T))
+	node := calculateOrbitalElement(elements[4], T)
+	perihelion := calculateOrbitalElement(elements[5], T)
+
+	M := macros.ConvertDegreesToRadiance(macros.AdjustAngleRange(math.Mod(L-perihelion, 360), 0, 360))
+	E := macros.CalculateEccentricAnomaly(M, e)
+	v := macros.ConvertRadianceToDegree(2 * math.Atan(math.Sqrt((1+e)/(1-e))*math.Tan(E/2)))
+	radiusAU = a * (1 - (e * math.Cos(E)))
+
+	// Argument of latitude measured from the ascending node
+	u := macros.ConvertDegreesToRadiance(v + perihelion - node)
+	longitude = macros.AdjustAngleRange(math.Mod(node+macros.ConvertRadianceToDegree(math.Atan2(math.Cos(i)*math.Sin(u), math.Cos(u))), 360), 0, 360)
+	latitude = macros.ConvertRadianceToDegree(math.Asin(math.Sin(i) * math.Sin(u)))
+
+	return longitude, latitude, radiusAU
+}
+
+func CalculateGeometricPositionOfPlanet(julianEphemerisDate float64, planetName string) (x, y, z, distanceAU, lightTime, l, b, r float64) {
+	// Geocentric rectangular ecliptic coordinates corrected for light-time, and the heliocentric coordinates of
+	// the planet at the instant the light left it, all referred to the mean equinox of date
+	earthLongitude, earthLatitude, earthRadius := CalculateHeliocentricEclipticCoordinatesOfPlanet(julianEphemerisDate, "Earth")
+	earthL, earthB := macros.ConvertDegreesToRadiance(earthLongitude), macros.ConvertDegreesToRadiance(earthLatitude)
+	for i := 0; i < 3; i++ {
+		l, b, r = CalculateHeliocentricEclipticCoordinatesOfPlanet(julianEphemerisDate-lightTime, planetName)
+		lRad, bRad := macros.ConvertDegreesToRadiance(l), macros.ConvertDegreesToRadiance(b)
+		x = (r * math.Cos(bRad) * math.Cos(lRad)) - (earthRadius * math.Cos(earthB) * math.Cos(earthL))
+		y = (r * math.Cos(bRad) * math.Sin(lRad)) - (earthRadius * math.Cos(earthB) * math.Sin(earthL))
+		z = (r * math.Sin(bRad)) - (earthRadius * math.Sin(earthB))
+		distanceAU = math.Sqrt(math.Pow(x, 2) + math.Pow(y, 2) + math.Pow(z, 2))
+		lightTime = LightTimeDaysPerAU * distanceAU
+	}
+	return x, y, z, distanceAU, lightTime, l, b, r
+}
+
+func CalculateApparentPositionOfPlanet(julianEphemerisDate float64, planetName string) (raHrs, raMin int, raSec float64, decDeg, decMin int, decSec, raDecimalHrs, decDecimalDeg, distanceAU float64) {
+	// Geocentric apparent position corrected for light-time, aberration and nutation, planetName may also be "Sun"
+	earthLongitude, _, _ := CalculateHeliocentricEclipticCoordinatesOfPlanet(julianEphemerisDate, "Earth")
+	x, y, z, distanceAU, _, _, _, _ := CalculateGeometricPositionOfPlanet(julianEphemerisDate, planetName)
+
+	lambda, beta := vecmat.Vec3{x, y, z}.ConvertToSphericalDecimalDeg()
+	deltaLambda, deltaBeta := coords.CalculateAberrationDecimalDeg(julianEphemerisDate, lambda, beta, earthLongitude+180)
+
+	nutationInLong, _ := coords.CalculateNutationIAU2000B(julianEphemerisDate)
+	raDecimalDeg, decDecimalDeg := coords.ConvertEclipticDecimalDegToEquatorial(lambda+deltaLambda+nutationInLong, beta+deltaBeta, coords.CalculateTrueObliquity(julianEphemerisDate))
+	raDecimalHrs = macros.ConvertDecimalDegressToDecimalHrs(raDecimalDeg)
+
+	raHrs, raMin, raSec = datetime.ConvertDecimalHrsToHrsMinSec(raDecimalHrs)
+	decDeg, decMin, decSec = macros.ConvertDecimalDegToDegMinSec(decDecimalDeg)
+
+	return raHrs, raMin, raSec, decDeg, decMin, decSec, raDecimalHrs, decDecimalDeg, distanceAU
+}
@@ -0,0 +1,424 @@
+package satellite
+
+import (
+	"math"
+)
+
+// Earth rotation rate in radians per minute used by the resonance terms
+const earthRotationPerMinute = 4.37526908801129966e-3
+
+// deepSpaceTerms holds the lunar and solar coefficients of the SDP4 theory and, for orbits in resonance with
+// the rotation of the Earth, the resonance coefficients. resonance is 0 without resonance, 1 for synchronous
+// orbits and 2 for half-day orbits of high eccentricity such as Molniya.
+type deepSpaceTerms struct {
+	e3, ee2, se2, se3, sgh2, sgh3, sgh4, sh2, sh3, si2, si3, sl2, sl3, sl4 float64
+	xgh2, xgh3, xgh4, xh2, xh3, xi2, xi3, xl2, xl3, xl4, zmol, zmos        float64
+
+	resonance                                                            int
+	d2201, d2211, d3210, d3222, d4410, d4422, d5220, d5232, d5421, d5433 float64
+	dedt, didt, dmdt, dnodt, domdt, del1, del2, del3, xfact, xlamo       float64
+}
+
+// Solar and lunar perturbation coefficients of one body, the s, z and ss, sz terms of the original code
+type thirdBodyCoefficients struct {
+	s1, s2, s3, s4, s5, s6, s7                              float64
+	z1, z2, z3, z11, z12, z13, z21, z22, z23, z31, z32, z33 float64
+}
+
+func calculateThirdBodyCoefficients(zcosg, zsing, zcosi, zsini, zcosh, zsinh, cc, xnoi, em, emsq, betasq, rtemsq, sinim, cosim, sinomm, cosomm float64) thirdBodyCoefficients {
+	// Coefficients of the perturbations by the Sun or the Moon for the given orientation of its orbit
+	c := thirdBodyCoefficients{}
+	a1 := (zcosg * zcosh) + (zsing * zcosi * zsinh)
+	a3 := (-zsing * zcosh) + (zcosg * zcosi * zsinh)
+	a7 := (-zcosg * zsinh) + (zsing * zcosi * zcosh)
+	a8 := zsing * zsini
+	a9 := (zsing * zsinh) + (zcosg * zcosi * zcosh)
+	a10 := zcosg * zsini
+	a2 := (cosim * a7) + (sinim * a8)
+	a4 := (cosim * a9) + (sinim * a10)
+	a5 := (-sinim * a7) + (cosim * a8)
+	a6 := (-sinim * a9) + (cosim * a10)
+
+	x1 := (a1 * cosomm) + (a2 * sinomm)
+	x2 := (a3 * cosomm) + (a4 * sinomm)
+	x3 := (-a1 * sinomm) + (a2 * cosomm)
+	x4 := (-a3 * sinomm) + (a4 * cosomm)
+	x5 := a5 * sinomm
+	x6 := a6 * sinomm
+	x7 := a5 * cosomm
+	x8 := a6 * cosomm
+
+	c.z31 = (12 * x1 * x1) - (3 * x3 * x3)
+	c.z32 = (24 * x1 * x2) - (6 * x3 * x4)
+	c.z33 = (12 * x2 * x2) - (3 * x4 * x4)
+	c.z1 = (3 * ((a1 * a1) + (a2 * a2))) + (c.z31 * emsq)
+	c.z2 = (6 * ((a1 * a3) + (a2 * a4))) + (c.z32 * emsq)
+	c.z3 = (3 * ((a3 * a3) + (a4 * a4))) + (c.z33 * emsq)
+	c.z11 = (-6 * a1 * a5) + (emsq * ((-24 * x1 * x7) - (6 * x3 * x5)))
+	c.z12 = (-6 * ((a1 * a6) + (a3 * a5))) + (emsq * ((-24 * ((x2 * x7) + (x1 * x8))) - (6 * ((x3 * x6) + (x4 * x5)))))
+	c.z13 = (-6 * a3 * a6) + (emsq * ((-24 * x2 * x8) - (6 * x4 * x6)))
+	c.z21 = (6 * a2 * a5) + (emsq * ((24 * x1 * x5) - (6 * x3 * x7)))
+	c.z22 = (6 * ((a4 * a5) + (a2 * a6))) + (emsq * ((24 * ((x2 * x5) + (x1 * x6))) - (6 * ((x4 * x7) + (x3 * x8)))))
+	c.z23 = (6 * a4 * a6) + (emsq * ((24 * x2 * x6) - (6 * x4 * x8)))
+	c.z1 = c.z1 + c.z1 + (betasq * c.z31)
+	c.z2 = c.z2 + c.z2 + (betasq * c.z32)
+	c.z3 = c.z3 + c.z3 + (betasq * c.z33)
+
+	c.s3 = cc * xnoi
+	c.s2 = -0.5 * c.s3 / rtemsq
+	c.s4 = c.s3 * rtemsq
+	c.s1 = -15 * em * c.s4
+	c.s5 = (x1 * x3) + (x2 * x4)
+	c.s6 = (x2 * x3) + (x1 * x4)
+	c.s7 = (x2 * x4) - (x1 * x3)
+	return c
+}
+
+func (model *SGP4Model) initializeDeepSpace(xpidot float64) {
+	// Lunar and solar terms and resonance coefficients at the epoch (the dscom and dsinit routines)
+	const zes = 0.01675
+	const zel = 0.05490
+	const c1ss = 2.9864797e-6
+	const c1l = 4.7968065e-7
+	const zsinis = 0.39785416
+	const zcosis = 0.91744867
+	const zcosgs = 0.1945905
+	const zsings = -0.98088458
+	const zns = 1.19459e-5
+	const znl = 1.5835218e-4
+	deep := &model.deep
+
+	nm := model.noUnkozai
+	em := model.ecco
+	snodm, cnodm := math.Sin(model.nodeo), math.Cos(model.nodeo)
+	sinomm, cosomm := math.Sin(model.argpo), math.Cos(model.argpo)
+	sinim, cosim := math.Sin(model.inclo), math.Cos(model.inclo)
+	emsq := em * em
+	betasq := 1 - emsq
+	rtemsq := math.Sqrt(betasq)
+
+	// Orbit of the Moon at the epoch, days counted from 1950 January 0
+	day := model.Elements.Epoch - 2433281.5 + 18261.5
+	xnodce := math.Mod(4.5236020-(9.2422029e-4*day), twoPi)
+	stem, ctem := math.Sin(xnodce), math.Cos(xnodce)
+	zcosil := 0.91375164 - (0.03568096 * ctem)
+	zsinil := math.Sqrt(1 - (zcosil * zcosil))
+	zsinhl := 0.089683511 * stem / zsinil
+	zcoshl := math.Sqrt(1 - (zsinhl * zsinhl))
+	gam := 5.8351514 + (0.0019443680 * day)
+	zx := 0.39785416 * stem / zsinil
+	zy := (zcoshl * ctem) + (0.91744867 * zsinhl * stem)
+	zx = gam + math.Atan2(zx, zy) - xnodce
+	zcosgl, zsingl := math.Cos(zx), math.Sin(zx)
+
+	solar := calculateThirdBodyCoefficients(zcosgs, zsings, zcosis, zsinis, cnodm, snodm, c1ss, 1/nm, em, emsq, betasq, rtemsq, sinim, cosim, sinomm, cosomm)
+	zcosh := (zcoshl * cnodm) + (zsinhl * snodm)
+	zsinh := (snodm * zcoshl) - (cnodm * zsinhl)
+	lunar := calculateThirdBodyCoefficients(zcosgl, zsingl, zcosil, zsinil, zcosh, zsinh, c1l, 1/nm, em, emsq, betasq, rtemsq, sinim, cosim, sinomm, cosomm)
+
+	deep.zmol = math.Mod(4.7199672+(0.22997150*day)-gam, twoPi)
+	deep.zmos = math.Mod(6.2565837+(0.017201977*day), twoPi)
+
+	deep.se2 = 2 * solar.s1 * solar.s6
+	deep.se3 = 2 * solar.s1 * solar.s7
+	deep.si2 = 2 * solar.s2 * solar.z12
+	deep.si3 = 2 * solar.s2 * (solar.z13 - solar.z11)
+	deep.sl2 = -2 * solar.s3 * solar.z2
+	deep.sl3 = -2 * solar.s3 * (solar.z3 - solar.z1)
+	deep.sl4 = -2 * solar.s3 * (-21 - (9 * emsq)) * zes
+	deep.sgh2 = 2 * solar.s4 * solar.z32
+	deep.sgh3 = 2 * solar.s4 * (solar.z33 - solar.z31)
+	deep.sgh4 = -18 * solar.s4 * zes
+	deep.sh2 = -2 * solar.s2 * solar.z22
+	deep.sh3 = -2 * solar.s2 * (solar.z23 - solar.z21)
+
+	deep.ee2 = 2 * lunar.s1 * lunar.s6
+	deep.e3 = 2 * lunar.s1 * lunar.s7
+	deep.xi2 = 2 * lunar.s2 * lunar.z12
+	deep.xi3 = 2 * lunar.s2 * (lunar.z13 - lunar.z11)
+	deep.xl2 = -2 * lunar.s3 * lunar.z2
+	deep.xl3 = -2 * lunar.s3 * (lunar.z3 - lunar.z1)
+	deep.xl4 = -2 * lunar.s3 * (-21 - (9 * emsq)) * zel
+	deep.xgh2 = 2 * lunar.s4 * lunar.z32
+	deep.xgh3 = 2 * lunar.s4 * (lunar.z33 - lunar.z31)
+	deep.xgh4 = -18 * lunar.s4 * zel
+	deep.xh2 = -2 * lunar.s2 * lunar.z22
+	deep.xh3 = -2 * lunar.s2 * (lunar.z23 - lunar.z21)
+
+	// Secular rates from the Sun and the Moon
+	isNearlyEquatorial := model.inclo < 5.2359877e-2 || model.inclo > math.Pi-5.2359877e-2
+	ses := solar.s1 * zns * solar.s5
+	sis := solar.s2 * zns * (solar.z11 + solar.z13)
+	sls := -zns * solar.s3 * (solar.z1 + solar.z3 - 14 - (6 * emsq))
+	sghs := solar.s4 * zns * (solar.z31 + solar.z33 - 6)
+	shs := -zns * solar.s2 * (solar.z21 + solar.z23)
+	if isNearlyEquatorial {
+		shs = 0
+	}
+	if sinim != 0 {
+		shs /= sinim
+	}
+	sgs := sghs - (cosim * shs)
+
+	deep.dedt = ses + (lunar.s1 * znl * lunar.s5)
+	deep.didt = sis + (lunar.s2 * znl * (lunar.z11 + lunar.z13))
+	deep.dmdt = sls - (znl * lunar.s3 * (lunar.z1 + lunar.z3 - 14 - (6 * emsq)))
+	sghl := lunar.s4 * znl * (lunar.z31 + lunar.z33 - 6)
+	shll := -znl * lunar.s2 * (lunar.z21 + lunar.z23)
+	if isNearlyEquatorial {
+		shll = 0
+	}
+	deep.domdt = sgs + sghl
+	deep.dnodt = shs
+	if sinim != 0 {
+		deep.domdt -= cosim / sinim * shll
+		deep.dnodt += shll / sinim
+	}
+
+	// Resonances with the rotation of the Earth
+	if nm < 0.0052359877 && nm > 0.0034906585 {
+		deep.resonance = 1
+	}
+	if nm >= 8.26e-3 && nm <= 9.24e-3 && em >= 0.5 {
+		deep.resonance = 2
+	}
+	if deep.resonance == 0 {
+		return
+	}
+	theta := math.Mod(model.gsto, twoPi)
+	aonv := math.Pow(nm/xke, twoThirds)
+
+	if deep.resonance == 1 {
+		const q22 = 1.7891679e-6
+		const q31 = 2.1460748e-6
+		const q33 = 2.2123015e-7
+		g200 := 1 + (emsq * (-2.5 + (0.8125 * emsq)))
+		g310 := 1 + (2 * emsq)
+		g300 := 1 + (emsq * (-6 + (6.60937 * emsq)))
+		f220 := 0.75 * (1 + cosim) * (1 + cosim)
+		f311 := (0.9375 * sinim * sinim * (1 + (3 * cosim))) - (0.75 * (1 + cosim))
+		f330 := 1.875 * math.Pow(1+cosim, 3)
+		del1 := 3 * nm * nm * aonv * aonv
+		deep.del2 = 2 * del1 * f220 * g200 * q22
+		deep.del3 = 3 * del1 * f330 * g300 * q33 * aonv
+		deep.del1 = del1 * f311 * g310 * q31 * aonv
+		deep.xlamo = math.Mod(model.mo+model.nodeo+model.argpo-theta, twoPi)
+		deep.xfact = model.mdot + xpidot - earthRotationPerMinute + deep.dmdt + deep.domdt + deep.dnodt - model.noUnkozai
+		return
+	}
+
+	const root22 = 1.7891679e-6
+	const root32 = 3.7393792e-7
+	const root44 = 7.3636953e-9
+	const root52 = 1.1428639e-7
+	const root54 = 2.1765803e-9
+	cosisq := cosim * cosim
+	eoc := em * emsq
+	g201 := -0.306 - ((em - 0.64) * 0.440)
+	g211, g310, g322, g410, g422, g520 := 0.0, 0.0, 0.0, 0.0, 0.0, 0.0
+	if em <= 0.65 {
+		g211 = 3.616 - (13.2470 * em) + (16.2900 * emsq)
+		g310 = -19.302 + (117.3900 * em) - (228.4190 * emsq) + (156.5910 * eoc)
+		g322 = -18.9068 + (109.7927 * em) - (214.6334 * emsq) + (146.5816 * eoc)
+		g410 = -41.122 + (242.6940 * em) - (471.0940 * emsq) + (313.9530 * eoc)
+		g422 = -146.407 + (841.8800 * em) - (1629.014 * emsq) + (1083.4350 * eoc)
+		g520 = -532.114 + (3017.977 * em) - (5740.032 * emsq) + (3708.2760 * eoc)
+	} else {
+		g211 = -72.099 + (331.819 * em) - (508.738 * emsq) + (266.724 * eoc)
+		g310 = -346.844 + (1582.851 * em) - (2415.925 * emsq) + (1246.113 * eoc)
+		g322 = -342.585 + (1554.908 * em) - (2366.899 * emsq) + (1215.972 * eoc)
+		g410 = -1052.797 + (4758.686 * em) - (7193.992 * emsq) + (3651.957 * eoc)
+		g422 = -3581.690 + (16178.110 * em) - (24462.770 * emsq) + (12422.520 * eoc)
+		if em > 0.715 {
+			g520 = -5149.66 + (29936.92 * em) - (54087.36 * emsq) + (31324.56 * eoc)
+		} else {
+			g520 = 1464.74 - (4664.75 * em) + (3763.64 * emsq)
+		}
+	}
+	g533, g521, g532 := 0.0, 0.0, 0.0
+	if em < 0.7 {
+		g533 = -919.22770 + (4988.6100 * em) - (9064.7700 * emsq) + (5542.21 * eoc)
+		g521 = -822.71072 + (4568.6173 * em) - (8491.4146 * emsq) + (5337.524 * eoc)
+		g532 = -853.66600 + (4690.2500 * em) - (8624.7700 * emsq) + (5341.4 * eoc)
+	} else {
+		g533 = -37995.780 + (161616.52 * em) - (229838.20 * emsq) + (109377.94 * eoc)
+		g521 = -51752.104 + (218913.95 * em) - (309468.16 * emsq) + (146349.42 * eoc)
+		g532 = -40023.880 + (170470.89 * em) - (242699.48 * emsq) + (115605.82 * eoc)
+	}
+
+	sini2 := sinim * sinim
+	f220 := 0.75 * (1 + (2 * cosim) + cosisq)
+	f221 := 1.5 * sini2
+	f321 := 1.875 * sinim * (1 - (2 * cosim) - (3 * cosisq))
+	f322 := -1.875 * sinim * (1 + (2 * cosim) - (3 * cosisq))
+	f441 := 35 * sini2 * f220
+	f442 := 39.3750 * sini2 * sini2
+	f522 := 9.84375 * sinim * ((sini2 * (1 - (2 * cosim) - (5 * cosisq))) + (0.33333333 * (-2 + (4 * cosim) + (6 * cosisq))))
+	f523 := sinim * ((4.92187512 * sini2 * (-2 - (4 * cosim) + (10 * cosisq))) + (6.56250012 * (1 + (2 * cosim) - (3 * cosisq))))
+	f542 := 29.53125 * sinim * (2 - (8 * cosim) + (cosisq * (-12 + (8 * cosim) + (10 * cosisq))))
+	f543 := 29.53125 * sinim * (-2 - (8 * cosim) + (cosisq * (12 + (8 * cosim) - (10 * cosisq))))
+
+	temp1 := 3 * nm * nm * aonv * aonv
+	temp := temp1 * root22
+	deep.d2201 = temp * f220 * g201
+	deep.d2211 = temp * f221 * g211
+	temp1 *= aonv
+	temp = temp1 * root32
+	deep.d3210 = temp * f321 * g310
+	deep.d3222 = temp * f322 * g322
+	temp1 *= aonv
+	temp = 2 * temp1 * root44
+	deep.d4410 = temp * f441 * g410
+	deep.d4422 = temp * f442 * g422
+	temp1 *= aonv
+	temp = temp1 * root52
+	deep.d5220 = temp * f522 * g520
+	deep.d5232 = temp * f523 * g532
+	temp = 2 * temp1 * root54
+	deep.d5421 = temp * f542 * g521
+	deep.d5433 = temp * f543 * g533
+	deep.xlamo = math.Mod(model.mo+model.nodeo+model.nodeo-theta-theta, twoPi)
+	deep.xfact = model.mdot + deep.dmdt + (2 * (model.nodedot + deep.dnodt - earthRotationPerMinute)) - model.noUnkozai
+}
+
+func (model SGP4Model) calculateDeepSpaceSecularEffects(t, em, argpm, inclm, mm, nodem float64) (float64, float64, float64, float64, float64, float64) {
+	// Secular lunar and solar effects and the numerical integration of the resonance terms in 720 minute steps
+	// from the epoch (the dspace routine), returns em, argpm, inclm, mm, nodem and the mean motion
+	const fasx2 = 0.13130908
+	const fasx4 = 2.8843198
+	const fasx6 = 0.37448087
+	const g22 = 5.7686396
+	const g32 = 0.95240898
+	const g44 = 1.8014998
+	const g52 = 1.0508330
+	const g54 = 4.4108898
+	const step = 720.0
+	const step2 = 259200.0
+	deep := model.deep
+
+	theta := math.Mod(model.gsto+(t*earthRotationPerMinute), twoPi)
+	em += deep.dedt * t
+	inclm += deep.didt * t
+	argpm += deep.domdt * t
+	nodem += deep.dnodt * t
+	mm += deep.dmdt * t
+	if deep.resonance == 0 {
+		return em, argpm, inclm, mm, nodem, model.noUnkozai
+	}
+
+	delt := step
+	if t < 0 {
+		delt = -step
+	}
+	atime, xni, xli := 0.0, model.noUnkozai, deep.xlamo
+	xndt, xldot, xnddt, ft := 0.0, 0.0, 0.0, 0.0
+	for {
+		if deep.resonance != 2 {
+			xndt = (deep.del1 * math.Sin(xli-fasx2)) + (deep.del2 * math.Sin(2*(xli-fasx4))) + (deep.del3 * math.Sin(3*(xli-fasx6)))
+			xldot = xni + deep.xfact
+			xnddt = (deep.del1 * math.Cos(xli-fasx2)) + (2 * deep.del2 * math.Cos(2*(xli-fasx4))) + (3 * deep.del3 * math.Cos(3*(xli-fasx6)))
+			xnddt *= xldot
+		} else {
+			xomi := model.argpo + (model.argpdot * atime)
+			x2omi := xomi + xomi
+			x2li := xli + xli
+			xndt = (deep.d2201 * math.Sin(x2omi+xli-g22)) + (deep.d2211 * math.Sin(xli-g22)) +
+				(deep.d3210 * math.Sin(xomi+xli-g32)) + (deep.d3222 * math.Sin(-xomi+xli-g32)) +
+				(deep.d4410 * math.Sin(x2omi+x2li-g44)) + (deep.d4422 * math.Sin(x2li-g44)) +
+				(deep.d5220 * math.Sin(xomi+xli-g52)) + (deep.d5232 * math.Sin(-xomi+xli-g52)) +
+				(deep.d5421 * math.Sin(xomi+x2li-g54)) + (deep.d5433 * math.Sin(-xomi+x2li-g54))
+			xldot = xni + deep.xfact
+			xnddt = (deep.d2201 * math.Cos(x2omi+xli-g22)) + (deep.d2211 * math.Cos(xli-g22)) +
+				(deep.d3210 * math.Cos(xomi+xli-g32)) + (deep.d3222 * math.Cos(-xomi+xli-g32)) +
+				(deep.d5220 * math.Cos(xomi+xli-g52)) + (deep.d5232 * math.Cos(-xomi+xli-g52)) +
+				(2 * ((deep.d4410 * math.Cos(x2omi+x2li-g44)) + (deep.d4422 * math.Cos(x2li-g44)) +
+					(deep.d5421 * math.Cos(xomi+x2li-g54)) + (deep.d5433 * math.Cos(-xomi+x2li-g54))))
+			xnddt *= xldot
+		}
+		if math.Abs(t-atime) < step {
+			ft = t - atime
+			break
+		}
+		xli += (xldot * delt) + (xndt * step2)
+		xni += (xndt * delt) + (xnddt * step2)
+		atime += delt
+	}
+
+	nm := xni + (xndt * ft) + (xnddt * ft * ft * 0.5)
+	xl := xli + (xldot * ft) + (xndt * ft * ft * 0.5)
+	if deep.resonance != 1 {
+		mm = xl - (2 * nodem) + (2 * theta)
+	} else {
+		mm = xl - nodem - argpm + theta
+	}
+	return em, argpm, inclm, mm, nodem, nm
+}
+
+func (deep deepSpaceTerms) calculatePeriodics(t, ep, inclp, nodep, argpp, mp float64) (float64, float64, float64, float64, float64) {
+	// Long period lunar and solar periodics (the dpper routine), returns ep, inclp, nodep, argpp and mp
+	const zns = 1.19459e-5
+	const zes = 0.01675
+	const znl = 1.5835218e-4
+	const zel = 0.05490
+
+	zm := deep.zmos + (zns * t)
+	zf := zm + (2 * zes * math.Sin(zm))
+	sinzf := math.Sin(zf)
+	f2 := (0.5 * sinzf * sinzf) - 0.25
+	f3 := -0.5 * sinzf * math.Cos(zf)
+	ses := (deep.se2 * f2) + (deep.se3 * f3)
+	sis := (deep.si2 * f2) + (deep.si3 * f3)
+	sls := (deep.sl2 * f2) + (deep.sl3 * f3) + (deep.sl4 * sinzf)
+	sghs := (deep.sgh2 * f2) + (deep.sgh3 * f3) + (deep.sgh4 * sinzf)
+	shs := (deep.sh2 * f2) + (deep.sh3 * f3)
+
+	zm = deep.zmol + (znl * t)
+	zf = zm + (2 * zel * math.Sin(zm))
+	sinzf = math.Sin(zf)
+	f2 = (0.5 * sinzf * sinzf) - 0.25
+	f3 = -0.5 * sinzf * math.Cos(zf)
+	sel := (deep.ee2 * f2) + (deep.e3 * f3)
+	sil := (deep.xi2 * f2) + (deep.xi3 * f3)
+	sll := (deep.xl2 * f2) + (deep.xl3 * f3) + (deep.xl4 * sinzf)
+	sghl := (deep.xgh2 * f2) + (deep.xgh3 * f3) + (deep.xgh4 * sinzf)
+	shll := (deep.xh2 * f2) + (deep.xh3 * f3)
+
+	pe := ses + sel
+	pinc := sis + sil
+	pl := sls + sll
+	pgh := sghs + sghl
+	ph := shs + shll
+
+	inclp += pinc
+	ep += pe
+	sinip, cosip := math.Sin(inclp), math.Cos(inclp)
+	if inclp >= 0.2 {
+		ph /= sinip
+		pgh -= cosip * ph
+		argpp += pgh
+		nodep += ph
+		mp += pl
+		return ep, inclp, nodep, argpp, mp
+	}
+
+	// Lyddane's modification for low inclinations
+	sinop, cosop := math.Sin(nodep), math.Cos(nodep)
+	alfdp := (sinip * sinop) + (ph * cosop) + (pinc * cosip * sinop)
+	betdp := (sinip * cosop) - (ph * sinop) + (pinc * cosip * cosop)
+	nodep = math.Mod(nodep, twoPi)
+	xls := mp + argpp + (cosip * nodep)
+	dls := pl + pgh - (pinc * nodep * sinip)
+	xls += dls
+	xnoh := nodep
+	nodep = math.Atan2(alfdp, betdp)
+	if math.Abs(xnoh-nodep) > math.Pi {
+		if nodep < xnoh {
+			nodep += twoPi
+		} else {
+			nodep -= twoPi
+		}
+	}
+	mp += pl
+	argpp = xls - mp - (cosip * nodep)
+	return ep, inclp, nodep, argpp, mp
+}
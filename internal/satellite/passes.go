@@ -0,0 +1,147 @@
+package satellite
+
+import (
+	"go-astronomy/internal/coords"
+	datetime "go-astronomy/internal/dateTime"
+	"go-astronomy/internal/macros"
+	"go-astronomy/internal/sun"
+	"math"
+)
+
+const kmPerAU = 149597870.7
+const sunRadiusKm = 696000.0
+
+// Altitude of the Sun in decimal degrees below which the observer is taken to be in darkness, the end of civil
+// twilight
+const darknessSunAltitude = -6.0
+
+// SatellitePass holds one pass of a satellite over an observer. Times are Julian dates in UTC, angles in decimal
+// degrees. IsSunlit is set when the satellite leaves the shadow of the Earth at some time during the pass and
+// IsVisible when it is also above the minimum elevation while the observer is in darkness, between
+// VisibleStart and VisibleEnd.
+type SatellitePass struct {
+	Rise                 float64
+	RiseAzimuth          float64
+	Culmination          float64
+	CulminationAzimuth   float64
+	MaxElevation         float64
+	Set                  float64
+	SetAzimuth           float64
+	IsSunlit             bool
+	IsObserverInDarkness bool
+	IsVisible            bool
+	VisibleStart         float64
+	VisibleEnd           float64
+}
+
+func calculateSunPosition(julianDate float64) (raDecimalDeg, decDecimalDeg float64, position [3]float64) {
+	// Apparent place of the Sun and its geocentric position in km referred to the true equator of date, which
+	// differs from TEME by less than the equation of the equinoxes
+	_, _, _, _, _, _, raDecimalHrs, decDecimalDeg, distanceAU := sun.CalculateApparentPositionOfSun(datetime.ConvertUniversalTimeToEphemerisTime(julianDate))
+	raDecimalDeg = raDecimalHrs * 15
+	ra, dec := macros.ConvertDegreesToRadiance(raDecimalDeg), macros.ConvertDegreesToRadiance(decDecimalDeg)
+	distanceKm := distanceAU * kmPerAU
+	position = [3]float64{distanceKm * math.Cos(dec) * math.Cos(ra), distanceKm * math.Cos(dec) * math.Sin(ra), distanceKm * math.Sin(dec)}
+	return raDecimalDeg, decDecimalDeg, position
+}
+
+func IsSatelliteSunlit(state StateVector, julianDate float64) bool {
+	// Whether the satellite is outside the umbra of the Earth, modelled as a cone whose vertex lies beyond the
+	// Earth on the side opposite the Sun. Satellites in the penumbra count as sunlit.
+	_, _, sunPosition := calculateSunPosition(julianDate)
+	sunDistance := math.Sqrt(math.Pow(sunPosition[0], 2) + math.Pow(sunPosition[1], 2) + math.Pow(sunPosition[2], 2))
+	sunDirection := [3]float64{sunPosition[0] / sunDistance, sunPosition[1] / sunDistance, sunPosition[2] / sunDistance}
+
+	// Distance along the shadow axis, positive towards the Sun, and from the axis
+	alongAxis := (state.Position[0] * sunDirection[0]) + (state.Position[1] * sunDirection[1]) + (state.Position[2] * sunDirection[2])
+	if alongAxis > 0 {
+		return true
+	}
+	fromAxis := math.Sqrt(math.Pow(state.Position[0]-(alongAxis*sunDirection[0]), 2) + math.Pow(state.Position[1]-(alongAxis*sunDirection[1]), 2) + math.Pow(state.Position[2]-(alongAxis*sunDirection[2]), 2))
+	umbraRadius := wgs84EquatorialRadiusKm + (alongAxis * (sunRadiusKm - wgs84EquatorialRadiusKm) / sunDistance)
+	return fromAxis > umbraRadius
+}
+
+func CalculateSunAltitude(julianDate float64, observer coords.Observer) float64 {
+	// Geometric altitude of the centre of the Sun in decimal degrees for an observer
+	raDecimalDeg, decDecimalDeg, _ := calculateSunPosition(julianDate)
+	hourAngle := coords.CalculateGreenwichApparentSiderealTime(julianDate) + observer.GeoLong - raDecimalDeg
+	altitude, _ := coords.ConvertHourAngleDecimalDegToHorizon(hourAngle, decDecimalDeg, observer.GeoLatN)
+	return altitude
+}
+
+func CalculateSatellitePasses(model SGP4Model, startJulianDate, endJulianDate float64, observer coords.Observer, minElevation float64) ([]SatellitePass, error) {
+	// Passes of a satellite above minElevation that begin between two Julian dates in UTC. A pass in progress at
+	// the end of the window is followed until it sets, for at most one day.
+	const step = 30.0 / 86400
+	const visibilityStep = 10.0 / 86400
+	var propagationError error
+	elevationAt := func(julianDate float64) TopocentricPosition {
+		position, err := CalculateTopocentricPosition(model, julianDate, observer)
+		if err != nil && propagationError == nil {
+			propagationError = err
+		}
+		return position
+	}
+	findCrossing := func(start, end float64) float64 {
+		return macros.FindTransition(func(julianDate float64) bool {
+			return elevationAt(julianDate).Elevation >= minElevation
+		}, start, end, 1e-9)
+	}
+	findCulmination := func(start, end float64) float64 {
+		return macros.FindMinimum(func(julianDate float64) float64 {
+			return -elevationAt(julianDate).Elevation
+		}, start, end, 0.1/86400)
+	}
+
+	passes := []SatellitePass{}
+	rise := 0.0
+	isAbove := elevationAt(startJulianDate).Elevation >= minElevation
+	for julianDate := startJulianDate + step; julianDate <= endJulianDate || (isAbove && rise > 0 && julianDate <= endJulianDate+1); julianDate += step {
+		if propagationError != nil {
+			return passes, propagationError
+		}
+		isAboveNow := elevationAt(julianDate).Elevation >= minElevation
+		if isAboveNow == isAbove {
+			continue
+		}
+		isAbove = isAboveNow
+		if isAbove {
+			rise = findCrossing(julianDate-step, julianDate)
+			continue
+		}
+		if rise == 0 {
+			continue
+		}
+
+		pass := SatellitePass{Rise: rise, Set: findCrossing(julianDate-step, julianDate)}
+		pass.Culmination = findCulmination(pass.Rise, pass.Set)
+		culmination := elevationAt(pass.Culmination)
+		pass.MaxElevation, pass.CulminationAzimuth = culmination.Elevation, culmination.Azimuth
+		pass.RiseAzimuth = elevationAt(pass.Rise).Azimuth
+		pass.SetAzimuth = elevationAt(pass.Set).Azimuth
+		pass.IsObserverInDarkness = CalculateSunAltitude(pass.Culmination, observer) < darknessSunAltitude
+
+		// Sample the pass for the times the satellite is lit while the sky is dark
+		for sample := pass.Rise; sample <= pass.Set; sample += visibilityStep {
+			state, err := CalculateTEMEStateVector(model, sample)
+			if err != nil {
+				return passes, err
+			}
+			if !IsSatelliteSunlit(state, sample) {
+				continue
+			}
+			pass.IsSunlit = true
+			if CalculateSunAltitude(sample, observer) < darknessSunAltitude {
+				if !pass.IsVisible {
+					pass.VisibleStart = sample
+				}
+				pass.IsVisible = true
+				pass.VisibleEnd = math.Min(sample+visibilityStep, pass.Set)
+			}
+		}
+		passes = append(passes, pass)
+		rise = 0
+	}
+	return passes, propagationError
+}
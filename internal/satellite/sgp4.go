@@ -0,0 +1,344 @@
+package satellite
+
+import (
+	"errors"
+	"math"
+)
+
+// WGS-72 constants the element sets are fitted with
+const earthRadiusKm = 6378.135
+const earthGravitationalParameter = 398600.8
+const j2 = 0.001082616
+const j3 = -0.00000253881
+const j4 = -0.00000165597
+const j3oj2 = j3 / j2
+const twoThirds = 2.0 / 3.0
+const twoPi = 2 * math.Pi
+const minutesPerDay = 1440.0
+
+// Square root of the gravitational parameter in Earth radii^1.5 per minute
+var xke = 60.0 / math.Sqrt(math.Pow(earthRadiusKm, 3)/earthGravitationalParameter)
+
+var ErrEccentricityOutOfRange = errors.New("sgp4: mean eccentricity out of range")
+var ErrNegativeMeanMotion = errors.New("sgp4: mean motion is negative")
+var ErrPerturbedEccentricityOutOfRange = errors.New("sgp4: perturbed eccentricity out of range")
+var ErrNegativeSemiLatusRectum = errors.New("sgp4: semi-latus rectum is negative")
+var ErrSatelliteDecayed = errors.New("sgp4: satellite has decayed")
+
+// StateVector holds a position in km and a velocity in km/s in the true equator, mean equinox (TEME) frame of
+// the SGP4 theory.
+type StateVector struct {
+	Position [3]float64
+	Velocity [3]float64
+}
+
+// SGP4Model holds an element set prepared for propagation. Orbits with a period of 225 minutes or more use the
+// deep space (SDP4) lunar, solar and resonance terms.
+type SGP4Model struct {
+	Elements    TwoLineElements
+	IsDeepSpace bool
+
+	ecco, inclo, nodeo, argpo, mo, bstar, noUnkozai    float64
+	isSimple                                           bool
+	aycof, con41, cc1, cc4, cc5, d2, d3, d4, delmo     float64
+	eta, argpdot, omgcof, sinmao, t2cof, t3cof, t4cof  float64
+	t5cof, x1mth2, x7thm1, mdot, nodedot, xlcof, xmcof float64
+	nodecf, gsto                                       float64
+	deep                                               deepSpaceTerms
+}
+
+func calculateGreenwichMeanSiderealAngle(julianDate float64) float64 {
+	// IAU 1982 Greenwich mean sidereal time in radians, the form the SGP4 theory is defined with
+	T := (julianDate - 2451545.0) / 36525.0
+	seconds := (-6.2e-6 * math.Pow(T, 3)) + (0.093104 * math.Pow(T, 2)) + (((876600.0 * 3600) + 8640184.812866) * T) + 67310.54841
+	angle := math.Mod(seconds*math.Pi/180/240, twoPi)
+	if angle < 0 {
+		angle += twoPi
+	}
+	return angle
+}
+
+func InitializeSGP4(tle TwoLineElements) (SGP4Model, error) {
+	// Recovers the original mean motion and semi-major axis and computes the secular and drag coefficients
+	// (Hoots and Roehrich, Spacetrack Report #3, as revised by Vallado et al. 2006)
+	model := SGP4Model{Elements: tle}
+	model.ecco = tle.Eccentricity
+	model.inclo = tle.Inclination * math.Pi / 180
+	model.nodeo = tle.AscendingNode * math.Pi / 180
+	model.argpo = tle.ArgumentOfPerigee * math.Pi / 180
+	model.mo = tle.MeanAnomaly * math.Pi / 180
+	model.bstar = tle.BStar
+	noKozai := tle.MeanMotion * twoPi / minutesPerDay
+
+	const temp4 = 1.5e-12
+	ss := (78.0 / earthRadiusKm) + 1
+	qzms2t := math.Pow((120.0-78.0)/earthRadiusKm, 4)
+
+	eccsq := math.Pow(model.ecco, 2)
+	omeosq := 1 - eccsq
+	rteosq := math.Sqrt(omeosq)
+	cosio := math.Cos(model.inclo)
+	cosio2 := math.Pow(cosio, 2)
+
+	// Un-Kozai the mean motion
+	ak := math.Pow(xke/noKozai, twoThirds)
+	d1 := 0.75 * j2 * ((3 * cosio2) - 1) / (rteosq * omeosq)
+	del := d1 / (ak * ak)
+	adel := ak * (1 - (del * del) - (del * ((1.0 / 3) + (134 * del * del / 81))))
+	del = d1 / (adel * adel)
+	model.noUnkozai = noKozai / (1 + del)
+
+	ao := math.Pow(xke/model.noUnkozai, twoThirds)
+	sinio := math.Sin(model.inclo)
+	po := ao * omeosq
+	con42 := 1 - (5 * cosio2)
+	model.con41 = -con42 - cosio2 - cosio2
+	posq := po * po
+	rp := ao * (1 - model.ecco)
+	model.gsto = calculateGreenwichMeanSiderealAngle(tle.Epoch)
+
+	if model.ecco >= 1 || model.noUnkozai <= 0 {
+		return model, ErrEccentricityOutOfRange
+	}
+	model.isSimple = rp < (220.0/earthRadiusKm)+1
+
+	// The density function depends on the perigee height when it is below 156 km
+	sfour := ss
+	qzms24 := qzms2t
+	perigee := (rp - 1) * earthRadiusKm
+	if perigee < 156 {
+		sfour = perigee - 78
+		if perigee < 98 {
+			sfour = 20
+		}
+		qzms24 = math.Pow((120-sfour)/earthRadiusKm, 4)
+		sfour = (sfour / earthRadiusKm) + 1
+	}
+	pinvsq := 1 / posq
+
+	tsi := 1 / (ao - sfour)
+	model.eta = ao * model.ecco * tsi
+	etasq := model.eta * model.eta
+	eeta := model.ecco * model.eta
+	psisq := math.Abs(1 - etasq)
+	coef := qzms24 * math.Pow(tsi, 4)
+	coef1 := coef / math.Pow(psisq, 3.5)
+	cc2 := coef1 * model.noUnkozai * ((ao * (1 + (1.5 * etasq) + (eeta * (4 + etasq)))) + (0.375 * j2 * tsi / psisq * model.con41 * (8 + (3 * etasq * (8 + etasq)))))
+	model.cc1 = model.bstar * cc2
+	cc3 := 0.0
+	if model.ecco > 1.0e-4 {
+		cc3 = -2 * coef * tsi * j3oj2 * model.noUnkozai * sinio / model.ecco
+	}
+	model.x1mth2 = 1 - cosio2
+	model.cc4 = 2 * model.noUnkozai * coef1 * ao * omeosq * ((model.eta * (2 + (0.5 * etasq))) + (model.ecco * (0.5 + (2 * etasq))) -
+		(j2 * tsi / (ao * psisq) * ((-3 * model.con41 * (1 - (2 * eeta) + (etasq * (1.5 - (0.5 * eeta))))) +
+			(0.75 * model.x1mth2 * ((2 * etasq) - (eeta * (1 + etasq))) * math.Cos(2*model.argpo)))))
+	model.cc5 = 2 * coef1 * ao * omeosq * (1 + (2.75 * (etasq + eeta)) + (eeta * etasq))
+
+	// Secular rates of the mean anomaly, the argument of perigee and the node
+	cosio4 := cosio2 * cosio2
+	temp1 := 1.5 * j2 * pinvsq * model.noUnkozai
+	temp2 := 0.5 * temp1 * j2 * pinvsq
+	temp3 := -0.46875 * j4 * pinvsq * pinvsq * model.noUnkozai
+	model.mdot = model.noUnkozai + (0.5 * temp1 * rteosq * model.con41) + (0.0625 * temp2 * rteosq * (13 - (78 * cosio2) + (137 * cosio4)))
+	model.argpdot = (-0.5 * temp1 * con42) + (0.0625 * temp2 * (7 - (114 * cosio2) + (395 * cosio4))) + (temp3 * (3 - (36 * cosio2) + (49 * cosio4)))
+	xhdot1 := -temp1 * cosio
+	model.nodedot = xhdot1 + (((0.5 * temp2 * (4 - (19 * cosio2))) + (2 * temp3 * (3 - (7 * cosio2)))) * cosio)
+	xpidot := model.argpdot + model.nodedot
+	model.omgcof = model.bstar * cc3 * math.Cos(model.argpo)
+	if model.ecco > 1.0e-4 {
+		model.xmcof = -twoThirds * coef * model.bstar / eeta
+	}
+	model.nodecf = 3.5 * omeosq * xhdot1 * model.cc1
+	model.t2cof = 1.5 * model.cc1
+	if math.Abs(cosio+1) > 1.5e-12 {
+		model.xlcof = -0.25 * j3oj2 * sinio * (3 + (5 * cosio)) / (1 + cosio)
+	} else {
+		model.xlcof = -0.25 * j3oj2 * sinio * (3 + (5 * cosio)) / temp4
+	}
+	model.aycof = -0.5 * j3oj2 * sinio
+	model.delmo = math.Pow(1+(model.eta*math.Cos(model.mo)), 3)
+	model.sinmao = math.Sin(model.mo)
+	model.x7thm1 = (7 * cosio2) - 1
+
+	if twoPi/model.noUnkozai >= 225 {
+		model.IsDeepSpace = true
+		model.isSimple = true
+		model.initializeDeepSpace(xpidot)
+	}
+
+	if !model.isSimple {
+		cc1sq := model.cc1 * model.cc1
+		model.d2 = 4 * ao * tsi * cc1sq
+		temp := model.d2 * tsi * model.cc1 / 3
+		model.d3 = ((17 * ao) + sfour) * temp
+		model.d4 = 0.5 * temp * ao * tsi * ((221 * ao) + (31 * sfour)) * model.cc1
+		model.t3cof = model.d2 + (2 * cc1sq)
+		model.t4cof = 0.25 * ((3 * model.d3) + (model.cc1 * ((12 * model.d2) + (10 * cc1sq))))
+		model.t5cof = 0.2 * ((3 * model.d4) + (12 * model.cc1 * model.d3) + (6 * model.d2 * model.d2) + (15 * cc1sq * ((2 * model.d2) + cc1sq)))
+	}
+
+	// Propagating to the epoch reports element sets the theory cannot handle
+	if _, err := model.Propagate(0); err != nil {
+		return model, err
+	}
+	return model, nil
+}
+
+func (model SGP4Model) Propagate(minutesSinceEpoch float64) (StateVector, error) {
+	// TEME position and velocity at a time given in minutes from the epoch of the element set
+	state := StateVector{}
+	t := minutesSinceEpoch
+
+	// Secular gravity and atmospheric drag
+	xmdf := model.mo + (model.mdot * t)
+	argpdf := model.argpo + (model.argpdot * t)
+	nodedf := model.nodeo + (model.nodedot * t)
+	argpm := argpdf
+	mm := xmdf
+	t2 := t * t
+	nodem := nodedf + (model.nodecf * t2)
+	tempa := 1 - (model.cc1 * t)
+	tempe := model.bstar * model.cc4 * t
+	templ := model.t2cof * t2
+
+	if !model.isSimple {
+		delomg := model.omgcof * t
+		delm := model.xmcof * (math.Pow(1+(model.eta*math.Cos(xmdf)), 3) - model.delmo)
+		temp := delomg + delm
+		mm = xmdf + temp
+		argpm = argpdf - temp
+		t3 := t2 * t
+		t4 := t3 * t
+		tempa = tempa - (model.d2 * t2) - (model.d3 * t3) - (model.d4 * t4)
+		tempe += model.bstar * model.cc5 * (math.Sin(mm) - model.sinmao)
+		templ += (model.t3cof * t3) + (t4 * (model.t4cof + (t * model.t5cof)))
+	}
+
+	nm := model.noUnkozai
+	em := model.ecco
+	inclm := model.inclo
+	if model.IsDeepSpace {
+		em, argpm, inclm, mm, nodem, nm = model.calculateDeepSpaceSecularEffects(t, em, argpm, inclm, mm, nodem)
+	}
+	if nm <= 0 {
+		return state, ErrNegativeMeanMotion
+	}
+
+	am := math.Pow(xke/nm, twoThirds) * tempa * tempa
+	nm = xke / math.Pow(am, 1.5)
+	em -= tempe
+	if em >= 1 || em < -0.001 {
+		return state, ErrEccentricityOutOfRange
+	}
+	if em < 1.0e-6 {
+		em = 1.0e-6
+	}
+	mm += model.noUnkozai * templ
+	xlm := mm + argpm + nodem
+	nodem = math.Mod(nodem, twoPi)
+	argpm = math.Mod(argpm, twoPi)
+	xlm = math.Mod(xlm, twoPi)
+	mm = math.Mod(xlm-argpm-nodem, twoPi)
+
+	// Lunar and solar periodics
+	ep, xincp, argpp, nodep, mp := em, inclm, argpm, nodem, mm
+	sinip, cosip := math.Sin(inclm), math.Cos(inclm)
+	aycof, xlcof, con41, x1mth2, x7thm1 := model.aycof, model.xlcof, model.con41, model.x1mth2, model.x7thm1
+	if model.IsDeepSpace {
+		ep, xincp, nodep, argpp, mp = model.deep.calculatePeriodics(t, ep, xincp, nodep, argpp, mp)
+		if xincp < 0 {
+			xincp = -xincp
+			nodep += math.Pi
+			argpp -= math.Pi
+		}
+		if ep < 0 || ep > 1 {
+			return state, ErrPerturbedEccentricityOutOfRange
+		}
+		sinip, cosip = math.Sin(xincp), math.Cos(xincp)
+		aycof = -0.5 * j3oj2 * sinip
+		if math.Abs(cosip+1) > 1.5e-12 {
+			xlcof = -0.25 * j3oj2 * sinip * (3 + (5 * cosip)) / (1 + cosip)
+		} else {
+			xlcof = -0.25 * j3oj2 * sinip * (3 + (5 * cosip)) / 1.5e-12
+		}
+		cosisq := cosip * cosip
+		con41 = (3 * cosisq) - 1
+		x1mth2 = 1 - cosisq
+		x7thm1 = (7 * cosisq) - 1
+	}
+
+	// Long period periodics
+	axnl := ep * math.Cos(argpp)
+	temp := 1 / (am * (1 - (ep * ep)))
+	aynl := (ep * math.Sin(argpp)) + (temp * aycof)
+	xl := mp + argpp + nodep + (temp * xlcof * axnl)
+
+	// Kepler's equation for the eccentric longitude
+	u := math.Mod(xl-nodep, twoPi)
+	eo1 := u
+	sineo1, coseo1 := 0.0, 0.0
+	tem5 := 9999.9
+	for i := 0; math.Abs(tem5) >= 1.0e-12 && i < 10; i++ {
+		sineo1, coseo1 = math.Sin(eo1), math.Cos(eo1)
+		tem5 = 1 - (coseo1 * axnl) - (sineo1 * aynl)
+		tem5 = (u - (aynl * coseo1) + (axnl * sineo1) - eo1) / tem5
+		tem5 = math.Max(-0.95, math.Min(0.95, tem5))
+		eo1 += tem5
+	}
+
+	// Short period periodics
+	ecose := (axnl * coseo1) + (aynl * sineo1)
+	esine := (axnl * sineo1) - (aynl * coseo1)
+	el2 := (axnl * axnl) + (aynl * aynl)
+	pl := am * (1 - el2)
+	if pl < 0 {
+		return state, ErrNegativeSemiLatusRectum
+	}
+	rl := am * (1 - ecose)
+	rdotl := math.Sqrt(am) * esine / rl
+	rvdotl := math.Sqrt(pl) / rl
+	betal := math.Sqrt(1 - el2)
+	temp = esine / (1 + betal)
+	sinu := am / rl * (sineo1 - aynl - (axnl * temp))
+	cosu := am / rl * (coseo1 - axnl + (aynl * temp))
+	su := math.Atan2(sinu, cosu)
+	sin2u := (cosu + cosu) * sinu
+	cos2u := 1 - (2 * sinu * sinu)
+	temp = 1 / pl
+	temp1 := 0.5 * j2 * temp
+	temp2 := temp1 * temp
+
+	mrt := (rl * (1 - (1.5 * temp2 * betal * con41))) + (0.5 * temp1 * x1mth2 * cos2u)
+	su -= 0.25 * temp2 * x7thm1 * sin2u
+	xnode := nodep + (1.5 * temp2 * cosip * sin2u)
+	xinc := xincp + (1.5 * temp2 * cosip * sinip * cos2u)
+	mvt := rdotl - (nm * temp1 * x1mth2 * sin2u / xke)
+	rvdot := rvdotl + (nm * temp1 * ((x1mth2 * cos2u) + (1.5 * con41)) / xke)
+
+	// Orientation vectors
+	sinsu, cossu := math.Sin(su), math.Cos(su)
+	snod, cnod := math.Sin(xnode), math.Cos(xnode)
+	sini, cosi := math.Sin(xinc), math.Cos(xinc)
+	xmx := -snod * cosi
+	xmy := cnod * cosi
+	ux := (xmx * sinsu) + (cnod * cossu)
+	uy := (xmy * sinsu) + (snod * cossu)
+	uz := sini * sinsu
+	vx := (xmx * cossu) - (cnod * sinsu)
+	vy := (xmy * cossu) - (snod * sinsu)
+	vz := sini * cossu
+
+	kmPerSecond := earthRadiusKm * xke / 60
+	state.Position = [3]float64{mrt * ux * earthRadiusKm, mrt * uy * earthRadiusKm, mrt * uz * earthRadiusKm}
+	state.Velocity = [3]float64{((mvt * ux) + (rvdot * vx)) * kmPerSecond, ((mvt * uy) + (rvdot * vy)) * kmPerSecond, ((mvt * uz) + (rvdot * vz)) * kmPerSecond}
+	if mrt < 1 {
+		return state, ErrSatelliteDecayed
+	}
+	return state, nil
+}
+
+func CalculateTEMEStateVector(model SGP4Model, julianDate float64) (StateVector, error) {
+	// TEME position and velocity at a Julian date in UTC
+	return model.Propagate((julianDate - model.Elements.Epoch) * minutesPerDay)
+}
@@ -0,0 +1,202 @@
+package satellite
+
+import (
+	"bufio"
+	"fmt"
+	datetime "go-astronomy/internal/dateTime"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// TwoLineElements holds a NORAD element set. Epoch is a Julian date in UTC, angles are in decimal degrees, the
+// mean motion is in revolutions per day and its derivatives in revolutions per day squared and cubed. The
+// elements are mean elements of the SGP4 theory and must not be used with any other propagator.
+type TwoLineElements struct {
+	Name                       string
+	CatalogNumber              string
+	Classification             string
+	InternationalDesignator    string
+	Epoch                      float64
+	MeanMotionFirstDerivative  float64
+	MeanMotionSecondDerivative float64
+	BStar                      float64
+	ElementSetNumber           int
+	Inclination                float64
+	AscendingNode              float64
+	Eccentricity               float64
+	ArgumentOfPerigee          float64
+	MeanAnomaly                float64
+	MeanMotion                 float64
+	RevolutionNumber           int
+}
+
+func CalculateTLEChecksum(line string) int {
+	// Modulo 10 sum of the digits of the first 68 columns, each minus sign counting as 1
+	sum := 0
+	for _, char := range line[:min(len(line), 68)] {
+		switch {
+		case char >= '0' && char <= '9':
+			sum += int(char - '0')
+		case char == '-':
+			sum++
+		}
+	}
+	return sum % 10
+}
+
+func parseTLEField(line string, first, last int) (float64, error) {
+	// Number in the columns first to last, counted from 1 as in the format description
+	field := strings.TrimSpace(line[first-1 : last])
+	value, err := strconv.ParseFloat(field, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q in columns %d-%d", field, first, last)
+	}
+	return value, nil
+}
+
+func parseTLEExponentField(line string, first, last int) (float64, error) {
+	// Number with an assumed leading decimal point and a power of ten, " 28098-4" is 0.28098e-4
+	field := strings.TrimSpace(line[first-1 : last])
+	if field == "" {
+		return 0, nil
+	}
+	sign := 1.0
+	if field[0] == '-' || field[0] == '+' {
+		if field[0] == '-' {
+			sign = -1
+		}
+		field = field[1:]
+	}
+	if len(field) < 3 {
+		return 0, fmt.Errorf("invalid exponent field in columns %d-%d", first, last)
+	}
+	mantissa, err := strconv.ParseFloat("0."+field[:len(field)-2], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid exponent field in columns %d-%d", first, last)
+	}
+	exponent, err := strconv.Atoi(field[len(field)-2:])
+	if err != nil {
+		return 0, fmt.Errorf("invalid exponent field in columns %d-%d", first, last)
+	}
+	return sign * mantissa * math.Pow(10, float64(exponent)), nil
+}
+
+func validateTLELine(line string, lineNumber byte) error {
+	// Checks the length, the line number and the checksum in column 69
+	if len(line) < 69 {
+		return fmt.Errorf("line %c of the element set is shorter than 69 columns", lineNumber)
+	}
+	if line[0] != lineNumber {
+		return fmt.Errorf("line %c of the element set starts with %q", lineNumber, line[0])
+	}
+	if checksum := CalculateTLEChecksum(line); int(line[68]-'0') != checksum {
+		return fmt.Errorf("checksum of line %c is %c, expected %d", lineNumber, line[68], checksum)
+	}
+	return nil
+}
+
+func ParseTLE(name, line1, line2 string) (TwoLineElements, error) {
+	// Element set from its two lines, the name is the title line of the three line format and may be empty
+	tle := TwoLineElements{Name: strings.TrimSpace(strings.TrimPrefix(name, "0 "))}
+	line1, line2 = strings.TrimRight(line1, "\r\n "), strings.TrimRight(line2, "\r\n ")
+	if err := validateTLELine(line1, '1'); err != nil {
+		return tle, err
+	}
+	if err := validateTLELine(line2, '2'); err != nil {
+		return tle, err
+	}
+	tle.CatalogNumber = strings.TrimSpace(line1[2:7])
+	if strings.TrimSpace(line2[2:7]) != tle.CatalogNumber {
+		return tle, fmt.Errorf("catalog numbers of the two lines differ")
+	}
+	tle.Classification = string(line1[7])
+	tle.InternationalDesignator = strings.TrimSpace(line1[9:17])
+
+	// Two digit years from 57 onwards belong to the 1900s
+	year, err := strconv.Atoi(strings.TrimSpace(line1[18:20]))
+	if err != nil {
+		return tle, fmt.Errorf("invalid epoch year %q", line1[18:20])
+	}
+	if year < 57 {
+		year += 2000
+	} else {
+		year += 1900
+	}
+	dayOfYear, err := parseTLEField(line1, 21, 32)
+	if err != nil {
+		return tle, err
+	}
+	tle.Epoch = datetime.ConvertGreenwichDateToJulianDate(0, 1, year) + dayOfYear
+
+	if tle.MeanMotionFirstDerivative, err = parseTLEField(line1, 34, 43); err != nil {
+		return tle, err
+	}
+	tle.MeanMotionFirstDerivative *= 2
+	if tle.MeanMotionSecondDerivative, err = parseTLEExponentField(line1, 45, 52); err != nil {
+		return tle, err
+	}
+	tle.MeanMotionSecondDerivative *= 6
+	if tle.BStar, err = parseTLEExponentField(line1, 54, 61); err != nil {
+		return tle, err
+	}
+	tle.ElementSetNumber, _ = strconv.Atoi(strings.TrimSpace(line1[64:68]))
+
+	columns := []struct {
+		value       *float64
+		first, last int
+	}{
+		{&tle.Inclination, 9, 16},
+		{&tle.AscendingNode, 18, 25},
+		{&tle.ArgumentOfPerigee, 35, 42},
+		{&tle.MeanAnomaly, 44, 51},
+		{&tle.MeanMotion, 53, 63},
+	}
+	for _, column := range columns {
+		if *column.value, err = parseTLEField(line2, column.first, column.last); err != nil {
+			return tle, err
+		}
+	}
+	eccentricity, err := strconv.Atoi(strings.TrimSpace(line2[26:33]))
+	if err != nil {
+		return tle, fmt.Errorf("invalid eccentricity %q", line2[26:33])
+	}
+	tle.Eccentricity = float64(eccentricity) / 1e7
+	tle.RevolutionNumber, _ = strconv.Atoi(strings.TrimSpace(line2[63:68]))
+
+	return tle, nil
+}
+
+func ReadTLEFile(path string) ([]TwoLineElements, error) {
+	// Element sets of a file in the two or three line format, a line that starts neither with "1 " nor with "2 "
+	// is taken as the name of the element set that follows
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	elementSets := []TwoLineElements{}
+	name, line1 := "", ""
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimRight(scanner.Text(), "\r ")
+		switch {
+		case strings.TrimSpace(line) == "":
+			continue
+		case strings.HasPrefix(line, "1 "):
+			line1 = line
+		case strings.HasPrefix(line, "2 ") && line1 != "":
+			tle, err := ParseTLE(name, line1, line)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: %w", path, lineNumber, err)
+			}
+			elementSets = append(elementSets, tle)
+			name, line1 = "", ""
+		default:
+			name = line
+		}
+	}
+	return elementSets, scanner.Err()
+}
@@ -0,0 +1,86 @@
+package satellite
+
+import (
+	"go-astronomy/internal/coords"
+	datetime "go-astronomy/internal/dateTime"
+	"go-astronomy/internal/macros"
+	"math"
+)
+
+// WGS-84 ellipsoid for the position of the observer
+const wgs84EquatorialRadiusKm = 6378.137
+const wgs84Flattening = 1 / 298.257223563
+
+// Rotation rate of the Earth in radians per second
+const earthRotationRate = 7.292115e-5
+
+// TopocentricPosition holds the place of a satellite as seen by an observer. Azimuth is measured from the north
+// point eastwards and the elevation is geometric, both in decimal degrees. Range is in km and RangeRate, positive
+// when the satellite recedes, in km/s.
+type TopocentricPosition struct {
+	Azimuth   float64
+	Elevation float64
+	Range     float64
+	RangeRate float64
+}
+
+func ConvertTEMEToEarthFixed(state StateVector, julianDate float64) StateVector {
+	// Rotates a TEME state vector by the Greenwich mean sidereal time into the Earth fixed frame, polar motion is
+	// neglected
+	_, _, _, gst := datetime.ConvertJulianDateToGreenwichSiderealTime(julianDate)
+	theta := macros.ConvertDegreesToRadiance(gst * 15)
+	sinTheta, cosTheta := math.Sin(theta), math.Cos(theta)
+
+	earthFixed := StateVector{}
+	earthFixed.Position[0] = (cosTheta * state.Position[0]) + (sinTheta * state.Position[1])
+	earthFixed.Position[1] = (-sinTheta * state.Position[0]) + (cosTheta * state.Position[1])
+	earthFixed.Position[2] = state.Position[2]
+	earthFixed.Velocity[0] = (cosTheta * state.Velocity[0]) + (sinTheta * state.Velocity[1]) + (earthRotationRate * earthFixed.Position[1])
+	earthFixed.Velocity[1] = (-sinTheta * state.Velocity[0]) + (cosTheta * state.Velocity[1]) - (earthRotationRate * earthFixed.Position[0])
+	earthFixed.Velocity[2] = state.Velocity[2]
+	return earthFixed
+}
+
+func CalculateObserverEarthFixedPosition(observer coords.Observer) [3]float64 {
+	// Earth fixed position in km of an observer on the WGS-84 ellipsoid
+	lat := macros.ConvertDegreesToRadiance(observer.GeoLatN)
+	long := macros.ConvertDegreesToRadiance(observer.GeoLong)
+	heightKm := observer.HeightFromSeaLevel / 1000
+	eSquared := wgs84Flattening * (2 - wgs84Flattening)
+	N := wgs84EquatorialRadiusKm / math.Sqrt(1-(eSquared*math.Pow(math.Sin(lat), 2)))
+
+	return [3]float64{
+		(N + heightKm) * math.Cos(lat) * math.Cos(long),
+		(N + heightKm) * math.Cos(lat) * math.Sin(long),
+		((N * (1 - eSquared)) + heightKm) * math.Sin(lat),
+	}
+}
+
+func ConvertTEMEToTopocentric(state StateVector, julianDate float64, observer coords.Observer) TopocentricPosition {
+	// Azimuth, elevation, range and range rate of a satellite from its TEME state vector at a Julian date in UTC
+	earthFixed := ConvertTEMEToEarthFixed(state, julianDate)
+	site := CalculateObserverEarthFixedPosition(observer)
+	rho := [3]float64{earthFixed.Position[0] - site[0], earthFixed.Position[1] - site[1], earthFixed.Position[2] - site[2]}
+
+	lat := macros.ConvertDegreesToRadiance(observer.GeoLatN)
+	long := macros.ConvertDegreesToRadiance(observer.GeoLong)
+	east := (-math.Sin(long) * rho[0]) + (math.Cos(long) * rho[1])
+	north := (-math.Sin(lat) * math.Cos(long) * rho[0]) - (math.Sin(lat) * math.Sin(long) * rho[1]) + (math.Cos(lat) * rho[2])
+	up := (math.Cos(lat) * math.Cos(long) * rho[0]) + (math.Cos(lat) * math.Sin(long) * rho[1]) + (math.Sin(lat) * rho[2])
+
+	position := TopocentricPosition{}
+	position.Range = math.Sqrt(math.Pow(rho[0], 2) + math.Pow(rho[1], 2) + math.Pow(rho[2], 2))
+	position.Elevation = macros.ConvertRadianceToDegree(math.Asin(up / position.Range))
+	position.Azimuth = macros.AdjustAngleRange(macros.ConvertRadianceToDegree(math.Atan2(east, north)), 0, 360)
+	position.RangeRate = ((rho[0] * earthFixed.Velocity[0]) + (rho[1] * earthFixed.Velocity[1]) + (rho[2] * earthFixed.Velocity[2])) / position.Range
+	return position
+}
+
+func CalculateTopocentricPosition(model SGP4Model, julianDate float64, observer coords.Observer) (TopocentricPosition, error) {
+	// Place of a satellite for an observer at a Julian date in UTC
+	state, err := CalculateTEMEStateVector(model, julianDate)
+	if err != nil {
+		return TopocentricPosition{}, err
+	}
+	return ConvertTEMEToTopocentric(state, julianDate, observer), nil
+}
@@ -0,0 +1,241 @@
+package saturn
+
+import (
+	"go-astronomy/internal/coords"
+	"go-astronomy/internal/macros"
+	"math"
+)
+
+var SatelliteNames = [8]string{"Mimas", "Enceladus", "Tethys", "Dione", "Rhea", "Titan", "Hyperion", "Iapetus"}
+
+// Light speed in units of Saturn's equatorial radius per satellite period, for the differential light-time
+var differentialLightTime = [8]float64{20947, 23715, 26382, 29876, 35313, 53800, 59222, 91820}
+
+// SatellitePosition holds the apparent rectangular coordinates of a satellite referred to the centre of Saturn
+// in units of Saturn's equatorial radius. X is positive to the west, Y positive to the north and Z is positive
+// when the satellite is farther from the Earth than Saturn.
+type SatellitePosition struct {
+	X, Y, Z float64
+}
+
+// satelliteOrbit holds the longitude in the orbit, the radius vector in Saturn radii, the inclination on and the
+// node of the orbit, referred to the equatorial plane of Saturn at B1950.0
+type satelliteOrbit struct {
+	longitude, radius, gamma, omega float64
+}
+
+func calculateOrbitFromElements(lambdaDash, p, e, a, omega, i float64) satelliteOrbit {
+	// Longitude and radius vector of an eccentric and inclined orbit, referred to the equator of Saturn
+	M := lambdaDash - p
+	C := macros.ConvertRadianceToDegree((((2 * e) - (0.25 * math.Pow(e, 3)) + (0.0520833333 * math.Pow(e, 5))) * macros.SinDeg(M)) +
+		(((1.25 * math.Pow(e, 2)) - (0.458333333 * math.Pow(e, 4))) * macros.SinDeg(2*M)) +
+		(((1.083333333 * math.Pow(e, 3)) - (0.671875 * math.Pow(e, 5))) * macros.SinDeg(3*M)) +
+		(1.072917 * math.Pow(e, 4) * macros.SinDeg(4*M)) + (1.142708 * math.Pow(e, 5) * macros.SinDeg(5*M)))
+	g := omega - 168.8112
+	a1 := macros.SinDeg(i) * macros.SinDeg(g)
+	a2 := (macros.CosDeg(28.0817) * macros.SinDeg(i) * macros.CosDeg(g)) - (macros.SinDeg(28.0817) * macros.CosDeg(i))
+	u := macros.ConvertRadianceToDegree(math.Atan2(a1, a2))
+	h := (macros.CosDeg(28.0817) * macros.SinDeg(i)) - (macros.SinDeg(28.0817) * macros.CosDeg(i) * macros.CosDeg(g))
+	psi := macros.ConvertRadianceToDegree(math.Atan2(macros.SinDeg(28.0817)*macros.SinDeg(g), h))
+
+	return satelliteOrbit{
+		longitude: lambdaDash + C + u - g - psi,
+		radius:    a * (1 - math.Pow(e, 2)) / (1 + (e * macros.CosDeg(M+C))),
+		gamma:     macros.ConvertRadianceToDegree(math.Asin(math.Sqrt(math.Pow(a1, 2) + math.Pow(a2, 2)))),
+		omega:     168.8112 + u,
+	}
+}
+
+func calculateSatelliteOrbits(julianEphemerisDate float64) (orbits [8]satelliteOrbit) {
+	// Orbits of the eight major satellites at the instant the light left Saturn (Meeus chapter 46)
+	t1 := julianEphemerisDate - 2411093.0
+	t2 := t1 / 365.25
+	t3 := ((julianEphemerisDate - 2433282.423) / 365.25) + 1950.0
+	t4 := julianEphemerisDate - 2411368.0
+	t5 := t4 / 365.25
+	t6 := julianEphemerisDate - 2415020.0
+	t7 := t6 / 36525.0
+	t8 := t6 / 365.25
+	t9 := (julianEphemerisDate - 2442000.5) / 365.25
+	t10 := julianEphemerisDate - 2409786.0
+	t11 := t10 / 36525.0
+	W0 := 5.095 * (t3 - 1866.39)
+	W1 := 74.4 + (32.39 * t2)
+	W2 := 134.3 + (92.62 * t2)
+	W3 := 42.0 - (0.5118 * t5)
+	W4 := 276.59 + (0.5118 * t5)
+	W5 := 267.2635 + (1222.1136 * t7)
+	W6 := 175.4762 + (1221.5515 * t7)
+	W7 := 2.4891 + (0.002435 * t7)
+	W8 := 113.35 - (0.2597 * t7)
+	e1 := 0.05589 - (0.000346 * t7)
+
+	// Mimas
+	L := 127.64 + (381.994497 * t1) - (43.57 * macros.SinDeg(W0)) - (0.720 * macros.SinDeg(3*W0)) - (0.02144 * macros.SinDeg(5*W0))
+	M := L - (106.1 + (365.549 * t2))
+	C := (2.18287 * macros.SinDeg(M)) + (0.025988 * macros.SinDeg(2*M)) + (0.00043 * macros.SinDeg(3*M))
+	orbits[0] = satelliteOrbit{L + C, 3.06879 / (1 + (0.01905 * macros.CosDeg(M+C))), 1.563, 54.5 - (365.072 * t2)}
+
+	// Enceladus
+	L = 200.317 + (262.7319002 * t1) + (0.25667 * macros.SinDeg(W1)) + (0.20883 * macros.SinDeg(W2))
+	M = L - (309.107 + (123.44121 * t2))
+	C = (0.55577 * macros.SinDeg(M)) + (0.00168 * macros.SinDeg(2*M))
+	orbits[1] = satelliteOrbit{L + C, 3.94118 / (1 + (0.00485 * macros.CosDeg(M+C))), 0.0262, 348.0 - (151.95 * t2)}
+
+	// Tethys
+	L = 285.306 + (190.69791226 * t1) + (2.063 * macros.SinDeg(W0)) + (0.03409 * macros.SinDeg(3*W0)) + (0.001015 * macros.SinDeg(5*W0))
+	orbits[2] = satelliteOrbit{L, 4.880998, 1.0976, 111.33 - (72.2441 * t2)}
+
+	// Dione
+	L = 254.712 + (131.53493193 * t1) - (0.0215 * macros.SinDeg(W1)) - (0.01733 * macros.SinDeg(W2))
+	M = L - (174.8 + (30.820 * t2))
+	C = (0.24717 * macros.SinDeg(M)) + (0.00033 * macros.SinDeg(2*M))
+	orbits[3] = satelliteOrbit{L + C, 6.24871 / (1 + (0.002157 * macros.CosDeg(M+C))), 0.0139, 232.0 - (30.27 * t2)}
+
+	// Rhea
+	pDash := 342.7 + (10.057 * t2)
+	a1 := (0.000265 * macros.SinDeg(pDash)) + (0.001 * macros.SinDeg(W4))
+	a2 := (0.000265 * macros.CosDeg(pDash)) + (0.001 * macros.CosDeg(W4))
+	N := 345.0 - (10.057 * t2)
+	lambdaDash := 359.244 + (79.69004720 * t1) + (0.086754 * macros.SinDeg(N))
+	i := 28.0362 + (0.346898 * macros.CosDeg(N)) + (0.01930 * macros.CosDeg(W3))
+	omega := 168.8034 + (0.736936 * macros.SinDeg(N)) + (0.041 * macros.SinDeg(W3))
+	orbits[4] = calculateOrbitFromElements(lambdaDash, macros.ConvertRadianceToDegree(math.Atan2(a1, a2)), math.Sqrt(math.Pow(a1, 2)+math.Pow(a2, 2)), 8.725924, omega, i)
+
+	// Titan
+	L = 261.1582 + (22.57697855 * t4) + (0.074025 * macros.SinDeg(W3))
+	iDash := 27.45141 + (0.295999 * macros.CosDeg(W3))
+	omegaDash := 168.66925 + (0.628808 * macros.SinDeg(W3))
+	a1 = macros.SinDeg(W7) * macros.SinDeg(omegaDash-W8)
+	a2 = (macros.CosDeg(W7) * macros.SinDeg(iDash)) - (macros.SinDeg(W7) * macros.CosDeg(iDash) * macros.CosDeg(omegaDash-W8))
+	g0 := 102.8623
+	psi := macros.ConvertRadianceToDegree(math.Atan2(a1, a2))
+	s := math.Sqrt(math.Pow(a1, 2) + math.Pow(a2, 2))
+	g := W4 - omegaDash - psi
+	perisaturnium := 0.0
+	for j := 0; j < 3; j++ {
+		perisaturnium = W4 + (0.37515 * (macros.SinDeg(2*g) - macros.SinDeg(2*g0)))
+		g = perisaturnium - omegaDash - psi
+	}
+	eDash := 0.029092 + (0.00019048 * (macros.CosDeg(2*g) - macros.CosDeg(2*g0)))
+	q := 2 * (W5 - perisaturnium)
+	b1 := macros.SinDeg(iDash) * macros.SinDeg(omegaDash-W8)
+	b2 := (macros.CosDeg(W7) * macros.SinDeg(iDash) * macros.CosDeg(omegaDash-W8)) - (macros.SinDeg(W7) * macros.CosDeg(iDash))
+	theta := macros.ConvertRadianceToDegree(math.Atan2(b1, b2)) + W8
+	e := eDash + (0.002778797 * eDash * macros.CosDeg(q))
+	p := perisaturnium + (0.159215 * macros.SinDeg(q))
+	u := (2 * W5) - (2 * theta) + psi
+	h := (0.9375 * math.Pow(eDash, 2) * macros.SinDeg(q)) + (0.1875 * math.Pow(s, 2) * macros.SinDeg(2*(W5-theta)))
+	lambdaDash = L - (0.254744 * ((e1 * macros.SinDeg(W6)) + (0.75 * math.Pow(e1, 2) * macros.SinDeg(2*W6)) + h))
+	i = iDash + (0.031843 * s * macros.CosDeg(u))
+	omega = omegaDash + (0.031843 * s * macros.SinDeg(u) / macros.SinDeg(iDash))
+	orbits[5] = calculateOrbitFromElements(lambdaDash, p, e, 20.216193, omega, i)
+
+	// Hyperion
+	eta := 92.39 + (0.5621071 * t6)
+	zeta := 148.19 - (19.18 * t8)
+	theta = 184.8 - (35.41 * t9)
+	thetaDash := theta - 7.5
+	as := 176.0 + (12.22 * t8)
+	bs := 8.0 + (24.44 * t8)
+	cs := bs + 5.0
+	perisaturnium = 69.898 - (18.67088 * t8)
+	phi := 2 * (perisaturnium - W5)
+	chi := 94.9 - (2.292 * t8)
+	a := 24.50601 - (0.08686 * macros.CosDeg(eta)) - (0.00166 * macros.CosDeg(zeta+eta)) + (0.00175 * macros.CosDeg(zeta-eta))
+	e = 0.103458 - (0.004099 * macros.CosDeg(eta)) - (0.000167 * macros.CosDeg(zeta+eta)) + (0.000235 * macros.CosDeg(zeta-eta)) +
+		(0.02303 * macros.CosDeg(zeta)) - (0.00212 * macros.CosDeg(2*zeta)) + (0.000151 * macros.CosDeg(3*zeta)) + (0.00013 * macros.CosDeg(phi))
+	p = perisaturnium + (0.15648 * macros.SinDeg(chi)) - (0.4457 * macros.SinDeg(eta)) - (0.2657 * macros.SinDeg(zeta+eta)) - (0.3573 * macros.SinDeg(zeta-eta)) -
+		(12.872 * macros.SinDeg(zeta)) + (1.668 * macros.SinDeg(2*zeta)) - (0.2419 * macros.SinDeg(3*zeta)) - (0.07 * macros.SinDeg(phi))
+	lambdaDash = 177.047 + (16.91993829 * t6) + (0.15648 * macros.SinDeg(chi)) + (9.142 * macros.SinDeg(eta)) +
+		(0.007 * macros.SinDeg(2*eta)) - (0.014 * macros.SinDeg(3*eta)) + (0.2275 * macros.SinDeg(zeta+eta)) +
+		(0.2112 * macros.SinDeg(zeta-eta)) - (0.26 * macros.SinDeg(zeta)) - (0.0098 * macros.SinDeg(2*zeta)) -
+		(0.013 * macros.SinDeg(as)) + (0.017 * macros.SinDeg(bs)) - (0.0303 * macros.SinDeg(phi))
+	i = 27.3347 + (0.6434886 * macros.CosDeg(chi)) + (0.315 * macros.CosDeg(W3)) + (0.018 * macros.CosDeg(theta)) - (0.018 * macros.CosDeg(cs))
+	omega = 168.6812 + (1.40136 * macros.CosDeg(chi)) + (0.68599 * macros.SinDeg(W3)) - (0.0392 * macros.SinDeg(cs)) + (0.0366 * macros.SinDeg(thetaDash))
+	orbits[6] = calculateOrbitFromElements(lambdaDash, p, e, a, omega, i)
+
+	// Iapetus
+	L = 261.1582 + (22.57697855 * t4)
+	perisaturniumDash := 91.796 + (0.562 * t7)
+	psi = 4.367 - (0.195 * t7)
+	theta = 146.819 - (3.198 * t7)
+	phi = 60.470 + (1.521 * t7)
+	PHI := 205.055 - (2.091 * t7)
+	eDash = 0.028298 + (0.001156 * t11)
+	perisaturnium0 := 352.91 + (11.71 * t11)
+	mu := 76.3852 + (4.53795125 * t10)
+	iDash = 18.4602 - (0.9518 * t11) - (0.072 * math.Pow(t11, 2)) + (0.0054 * math.Pow(t11, 3))
+	omegaDash = 143.198 - (3.919 * t11) + (0.116 * math.Pow(t11, 2)) + (0.008 * math.Pow(t11, 3))
+	l := mu - perisaturnium0
+	g = perisaturnium0 - omegaDash - psi
+	g1 := perisaturnium0 - omegaDash - phi
+	ls := W5 - perisaturniumDash
+	gs := perisaturniumDash - theta
+	lT := L - W4
+	gT := W4 - PHI
+	u1 := 2 * (l + g - ls - gs)
+	u2 := l + g1 - lT - gT
+	u3 := l + (2 * (g - ls - gs))
+	u4 := lT + gT - g1
+	u5 := 2 * (ls + gs)
+	a = 58.935028 + (0.004638 * macros.CosDeg(u1)) + (0.058222 * macros.CosDeg(u2))
+	e = eDash - (0.0014097 * macros.CosDeg(g1-gT)) + (0.0003733 * macros.CosDeg(u5-(2*g))) +
+		(0.0001180 * macros.CosDeg(u3)) + (0.0002408 * macros.CosDeg(l)) + (0.0002849 * macros.CosDeg(l+u2)) + (0.0006190 * macros.CosDeg(u4))
+	w := (0.08077 * macros.SinDeg(g1-gT)) + (0.02139 * macros.SinDeg(u5-(2*g))) - (0.00676 * macros.SinDeg(u3)) +
+		(0.01380 * macros.SinDeg(l)) + (0.01632 * macros.SinDeg(l+u2)) + (0.03547 * macros.SinDeg(u4))
+	p = perisaturnium0 + (w / eDash)
+	lambdaDash = mu - (0.04299 * macros.SinDeg(u2)) - (0.00789 * macros.SinDeg(u1)) - (0.06312 * macros.SinDeg(ls)) -
+		(0.00295 * macros.SinDeg(2*ls)) - (0.02231 * macros.SinDeg(u5)) + (0.00650 * macros.SinDeg(u5+psi))
+	i = iDash + (0.04204 * macros.CosDeg(u5+psi)) + (0.00235 * macros.CosDeg(l+g1+lT+gT+phi)) + (0.00360 * macros.CosDeg(u2+phi))
+	wDash := (0.04204 * macros.SinDeg(u5+psi)) + (0.00235 * macros.SinDeg(l+g1+lT+gT+phi)) + (0.00358 * macros.SinDeg(u2+phi))
+	omega = omegaDash + (wDash / macros.SinDeg(iDash))
+	orbits[7] = calculateOrbitFromElements(lambdaDash, p, e, a, omega, i)
+
+	return orbits
+}
+
+func CalculatePositionsOfSatellites(julianEphemerisDate float64) (positions [8]SatellitePosition) {
+	// Apparent positions of Mimas, Enceladus, Tethys, Dione, Rhea, Titan, Hyperion and Iapetus relative to the
+	// disk of Saturn (Meeus chapter 46). The direction of Saturn is referred to the ecliptic of B1950.0 like the
+	// satellite theories.
+	lambda0, beta0, distanceAU, _, _, _, lightTime := calculateGeocentricPositionOfSaturn(julianEphemerisDate)
+	lambda0, beta0 = coords.CalculateEclipticPrecessionDecimalDeg(lambda0, beta0, julianEphemerisDate, 2433282.4235)
+	orbits := calculateSatelliteOrbits(julianEphemerisDate - lightTime)
+
+	// Rectangular coordinates referred to the equator of Saturn, the ninth point lies on Saturn's polar axis and
+	// gives its position angle
+	X, Y, Z := [9]float64{}, [9]float64{}, [9]float64{}
+	for j, orbit := range orbits {
+		u := orbit.longitude - orbit.omega
+		w := orbit.omega - 168.8112
+		X[j] = orbit.radius * ((macros.CosDeg(u) * macros.CosDeg(w)) - (macros.SinDeg(u) * macros.CosDeg(orbit.gamma) * macros.SinDeg(w)))
+		Y[j] = orbit.radius * ((macros.SinDeg(u) * macros.CosDeg(w) * macros.CosDeg(orbit.gamma)) + (macros.CosDeg(u) * macros.SinDeg(w)))
+		Z[j] = orbit.radius * macros.SinDeg(u) * macros.SinDeg(orbit.gamma)
+	}
+	Z[8] = 1
+
+	A, B, C := [9]float64{}, [9]float64{}, [9]float64{}
+	for j := range X {
+		// Rotate to the ecliptic of B1950.0 and then towards the Earth
+		a := X[j]
+		b := (macros.CosDeg(28.0817) * Y[j]) - (macros.SinDeg(28.0817) * Z[j])
+		c := (macros.SinDeg(28.0817) * Y[j]) + (macros.CosDeg(28.0817) * Z[j])
+		a, b = (macros.CosDeg(168.8112)*a)-(macros.SinDeg(168.8112)*b), (macros.SinDeg(168.8112)*a)+(macros.CosDeg(168.8112)*b)
+		A[j], b = (a*macros.SinDeg(lambda0))-(b*macros.CosDeg(lambda0)), (a*macros.CosDeg(lambda0))+(b*macros.SinDeg(lambda0))
+		B[j] = (b * macros.CosDeg(beta0)) + (c * macros.SinDeg(beta0))
+		C[j] = (c * macros.CosDeg(beta0)) - (b * macros.SinDeg(beta0))
+	}
+
+	D := math.Atan2(A[8], C[8])
+	for j := range positions {
+		x := (A[j] * math.Cos(D)) - (C[j] * math.Sin(D))
+		y := (A[j] * math.Sin(D)) + (C[j] * math.Cos(D))
+		z := B[j]
+		// Differential light-time and the effect of perspective
+		x += math.Abs(z) / differentialLightTime[j] * math.Sqrt(1-math.Pow(x/orbits[j].radius, 2))
+		W := distanceAU / (distanceAU + (z / 2475))
+		positions[j] = SatellitePosition{X: x * W, Y: y * W, Z: z}
+	}
+	return positions
+}
@@ -0,0 +1,82 @@
+package saturn
+
+import (
+	"go-astronomy/internal/coords"
+	"go-astronomy/internal/macros"
+	"go-astronomy/internal/planets"
+	vecmat "go-astronomy/internal/vecMat"
+	"math"
+)
+
+// Ratios of the ring edges to the outer edge of the outer ring, used to scale MajorAxis and MinorAxis
+const (
+	InnerEdgeOfOuterRing = 0.8801
+	OuterEdgeOfInnerRing = 0.8599
+	InnerEdgeOfInnerRing = 0.6650
+	InnerEdgeOfDuskyRing = 0.5486
+)
+
+// SaturnRing holds the appearance of the ring of Saturn (Meeus chapter 45). B and BDash are the saturnicentric
+// latitudes of the Earth and the Sun referred to the plane of the ring, DeltaU the difference between the
+// saturnicentric longitudes of the Sun and the Earth and PositionAngle the position angle of the northern
+// semi-minor axis, all in decimal degrees. The axes of the outer edge of the outer ring are in arcseconds.
+type SaturnRing struct {
+	B             float64
+	BDash         float64
+	DeltaU        float64
+	PositionAngle float64
+	MajorAxis     float64
+	MinorAxis     float64
+}
+
+func calculateGeocentricPositionOfSaturn(julianEphemerisDate float64) (lambda, beta, distanceAU, l, b, r, lightTime float64) {
+	// Geometric geocentric ecliptic coordinates of Saturn corrected for light-time, and its heliocentric
+	// coordinates at the instant the light left it
+	x, y, z, distanceAU, lightTime, l, b, r := planets.CalculateGeometricPositionOfPlanet(julianEphemerisDate, "Saturn")
+	lambda, beta = vecmat.Vec3{x, y, z}.ConvertToSphericalDecimalDeg()
+	return lambda, beta, distanceAU, l, b, r, lightTime
+}
+
+func CalculateRingOfSaturn(julianEphemerisDate float64) SaturnRing {
+	// Inclination and ascending node of the plane of the ring referred to the ecliptic and mean equinox of date
+	T := (julianEphemerisDate - 2451545.0) / 36525.0
+	i := 28.075216 - (0.012998 * T) + (0.000004 * math.Pow(T, 2))
+	node := 169.508470 + (1.394681 * T) + (0.000412 * math.Pow(T, 2))
+
+	lambda, beta, distanceAU, l, b, r, _ := calculateGeocentricPositionOfSaturn(julianEphemerisDate)
+	ring := SaturnRing{}
+	ring.B = macros.ConvertRadianceToDegree(math.Asin((macros.SinDeg(i) * macros.CosDeg(beta) * macros.SinDeg(lambda-node)) - (macros.CosDeg(i) * macros.SinDeg(beta))))
+	ring.MajorAxis = 375.35 / distanceAU
+	ring.MinorAxis = ring.MajorAxis * math.Abs(macros.SinDeg(ring.B))
+
+	// Heliocentric position corrected for the aberration of Saturn as seen from the Sun
+	N := 113.6655 + (0.8771 * T)
+	lDash := l - (0.01759 / r)
+	bDash := b - (0.000764 * macros.CosDeg(l-N) / r)
+	ring.BDash = macros.ConvertRadianceToDegree(math.Asin((macros.SinDeg(i) * macros.CosDeg(bDash) * macros.SinDeg(lDash-node)) - (macros.CosDeg(i) * macros.SinDeg(bDash))))
+	U1 := math.Atan2((macros.SinDeg(i)*macros.SinDeg(bDash))+(macros.CosDeg(i)*macros.CosDeg(bDash)*macros.SinDeg(lDash-node)), macros.CosDeg(bDash)*macros.CosDeg(lDash-node))
+	U2 := math.Atan2((macros.SinDeg(i)*macros.SinDeg(beta))+(macros.CosDeg(i)*macros.CosDeg(beta)*macros.SinDeg(lambda-node)), macros.CosDeg(beta)*macros.CosDeg(lambda-node))
+	ring.DeltaU = macros.ConvertRadianceToDegree(math.Abs(U1 - U2))
+
+	// Position angle from the equatorial coordinates of the pole of the ring and of Saturn, both corrected for
+	// nutation, Saturn also for the aberration of the Earth
+	nutationInLong, _ := coords.CalculateNutationIAU2000B(julianEphemerisDate)
+	obliquity := coords.CalculateTrueObliquity(julianEphemerisDate)
+	earthLongitude, _, _ := planets.CalculateHeliocentricEclipticCoordinatesOfPlanet(julianEphemerisDate, "Earth")
+	lambda, beta = lambda+(0.005693*macros.CosDeg(earthLongitude-lambda)/macros.CosDeg(beta)), beta+(0.005693*macros.SinDeg(earthLongitude-lambda)*macros.SinDeg(beta))
+	poleRA, poleDec := coords.ConvertEclipticDecimalDegToEquatorial(node-90+nutationInLong, 90-i, obliquity)
+	saturnRA, saturnDec := coords.ConvertEclipticDecimalDegToEquatorial(lambda+nutationInLong, beta, obliquity)
+	ring.PositionAngle = coords.CalculatePositionAngleDecimalDeg(saturnRA, saturnDec, poleRA, poleDec)
+	if ring.PositionAngle > 180 {
+		ring.PositionAngle -= 360
+	}
+
+	return ring
+}
+
+func CalculateMagnitudeOfSaturn(julianEphemerisDate float64) float64 {
+	// Visual magnitude of Saturn including its ring (Meeus chapter 41, Astronomical Almanac 1984)
+	_, _, distanceAU, _, _, r, _ := calculateGeocentricPositionOfSaturn(julianEphemerisDate)
+	ring := CalculateRingOfSaturn(julianEphemerisDate)
+	return -8.88 + (5 * math.Log10(r*distanceAU)) + (0.044 * ring.DeltaU) - (2.60 * math.Abs(macros.SinDeg(ring.B))) + (1.25 * math.Pow(macros.SinDeg(ring.B), 2))
+}
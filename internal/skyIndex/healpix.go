@@ -0,0 +1,129 @@
+package skyindex
+
+import (
+	"go-astronomy/internal/macros"
+	"math"
+)
+
+// Deepest HEALPix order whose pixel numbers fit in an int64
+const MaxOrder = 29
+
+// Ring number of the southernmost corner and longitude index of each of the 12 base pixels, in units of the
+// resolution parameter
+var baseRing = [12]int64{2, 2, 2, 2, 3, 3, 3, 3, 4, 4, 4, 4}
+var baseLongitude = [12]int64{1, 3, 5, 7, 0, 2, 4, 6, 1, 3, 5, 7}
+
+func spreadBits(value int64) int64 {
+	// Moves bit k of value to bit 2k
+	value &= 0xffffffff
+	value = (value | (value << 16)) & 0x0000ffff0000ffff
+	value = (value | (value << 8)) & 0x00ff00ff00ff00ff
+	value = (value | (value << 4)) & 0x0f0f0f0f0f0f0f0f
+	value = (value | (value << 2)) & 0x3333333333333333
+	value = (value | (value << 1)) & 0x5555555555555555
+	return value
+}
+
+func compressBits(value int64) int64 {
+	// Moves bit 2k of value to bit k
+	value &= 0x5555555555555555
+	value = (value | (value >> 1)) & 0x3333333333333333
+	value = (value | (value >> 2)) & 0x0f0f0f0f0f0f0f0f
+	value = (value | (value >> 4)) & 0x00ff00ff00ff00ff
+	value = (value | (value >> 8)) & 0x0000ffff0000ffff
+	value = (value | (value >> 16)) & 0x00000000ffffffff
+	return value
+}
+
+func ConvertDecimalDegToHEALPixel(raDecimalDeg, decDecimalDeg float64, order int) int64 {
+	// Number in the NESTED scheme of the HEALPix pixel of order 0 to MaxOrder (Gorski et al. 2005) containing a
+	// position, order k dividing the sphere into 12 * 4^k pixels of equal area
+	nside := int64(1) << order
+	z := math.Sin(macros.ConvertDegreesToRadiance(decDecimalDeg))
+	tt := math.Mod(raDecimalDeg/90, 4)
+	if tt < 0 {
+		tt += 4
+	}
+
+	var face, ix, iy int64
+	if math.Abs(z) <= 2.0/3 {
+		// Equatorial region
+		temp1 := float64(nside) * (0.5 + tt)
+		temp2 := float64(nside) * z * 0.75
+		jp := int64(temp1 - temp2)
+		jm := int64(temp1 + temp2)
+		ifp, ifm := jp>>order, jm>>order
+		switch {
+		case ifp == ifm:
+			face = ifp | 4
+		case ifp < ifm:
+			face = ifp
+		default:
+			face = ifm + 8
+		}
+		ix = jm & (nside - 1)
+		iy = nside - (jp & (nside - 1)) - 1
+	} else {
+		// Polar caps
+		ntt := min(int64(tt), 3)
+		tp := tt - float64(ntt)
+		tmp := float64(nside) * math.Sqrt(3*(1-math.Abs(z)))
+		jp := min(int64(tp*tmp), nside-1)
+		jm := min(int64((1-tp)*tmp), nside-1)
+		if z >= 0 {
+			face, ix, iy = ntt, nside-jm-1, nside-jp-1
+		} else {
+			face, ix, iy = ntt+8, jp, jm
+		}
+	}
+	return (face << (2 * order)) + spreadBits(ix) + (spreadBits(iy) << 1)
+}
+
+func ConvertHEALPixelToDecimalDeg(pixel int64, order int) (raDecimalDeg, decDecimalDeg float64) {
+	// Right ascension and declination of the centre of a HEALPix pixel in the NESTED scheme
+	nside := int64(1) << order
+	face := pixel >> (2 * order)
+	pixel &= (nside * nside) - 1
+	ix, iy := compressBits(pixel), compressBits(pixel>>1)
+
+	// Ring number counted from the north pole and the z coordinate of the centre
+	jr := (baseRing[face] * nside) - ix - iy - 1
+	var nr, kshift int64
+	var z float64
+	fact2 := 4.0 / float64(12*nside*nside)
+	switch {
+	case jr < nside:
+		nr = jr
+		z = 1 - (float64(nr*nr) * fact2)
+	case jr > 3*nside:
+		nr = (4 * nside) - jr
+		z = (float64(nr*nr) * fact2) - 1
+	default:
+		nr = nside
+		z = float64((2*nside)-jr) * 2 / float64(3*nside)
+		kshift = (jr - nside) & 1
+	}
+
+	jp := ((baseLongitude[face] * nr) + ix - iy + 1 + kshift) / 2
+	if jp > 4*nside {
+		jp -= 4 * nside
+	}
+	if jp < 1 {
+		jp += 4 * nside
+	}
+	raDecimalDeg = (float64(jp) - (float64(kshift+1) * 0.5)) * 90 / float64(nr)
+	decDecimalDeg = macros.ConvertRadianceToDegree(math.Asin(z))
+	return raDecimalDeg, decDecimalDeg
+}
+
+func CalculateHEALPixelMaxRadius(order int) float64 {
+	// Largest angular distance in decimal degrees from the centre of a pixel of an order to any of its points, the
+	// one between the centre and a corner of the pixels next to the poles
+	nside := float64(int64(1) << order)
+	z1, phi1 := 2.0/3, math.Pi/(4*nside)
+	t := math.Pow(1-(1/nside), 2)
+	z2 := 1 - (t / 3)
+	s1, s2 := math.Sqrt(1-(z1*z1)), math.Sqrt(1-(z2*z2))
+	cosAngle := (s1 * s2 * math.Cos(phi1)) + (z1 * z2)
+	return macros.ConvertRadianceToDegree(math.Acos(math.Max(-1, math.Min(1, cosAngle))))
+}
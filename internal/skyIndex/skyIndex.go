@@ -0,0 +1,272 @@
+package skyindex
+
+import (
+	"errors"
+	"go-astronomy/internal/macros"
+	starcatalog "go-astronomy/internal/starCatalog"
+	"math"
+	"sort"
+)
+
+var ErrInvalidOrder = errors.New("HEALPix order out of range")
+var ErrInvalidPolygon = errors.New("polygon must have at least three vertices and be convex")
+
+// SkyPoint holds a position on the celestial sphere
+type SkyPoint struct {
+	RADecimalHrs  float64
+	DecDecimalDeg float64
+}
+
+// Match holds a point found by a query, Index is its position in the slice the index was built from and Distance
+// the angle from the centre of the query in decimal degrees
+type Match struct {
+	Index    int
+	Distance float64
+}
+
+// SpatialIndex holds points sorted by the HEALPix pixel of order Order they lie in, so that the points of any
+// pixel of that order or coarser are a contiguous range. Order 6 to 8, pixels of 0.9 to 0.2 degrees, suit
+// catalogues of some ten to a hundred thousand stars.
+type SpatialIndex struct {
+	Order      int
+	pixels     []int64
+	indices    []int
+	vectors    [][3]float64
+	maxRadius  []float64
+	pointCount int
+}
+
+func convertSkyPointToVector(point SkyPoint) [3]float64 {
+	ra := macros.ConvertDegreesToRadiance(point.RADecimalHrs * 15)
+	dec := macros.ConvertDegreesToRadiance(point.DecDecimalDeg)
+	return [3]float64{math.Cos(dec) * math.Cos(ra), math.Cos(dec) * math.Sin(ra), math.Sin(dec)}
+}
+
+func calculateAngle(a, b [3]float64) float64 {
+	// Angle between two unit vectors in decimal degrees, accurate for small and large angles alike
+	cross := [3]float64{(a[1] * b[2]) - (a[2] * b[1]), (a[2] * b[0]) - (a[0] * b[2]), (a[0] * b[1]) - (a[1] * b[0])}
+	sine := math.Sqrt(math.Pow(cross[0], 2) + math.Pow(cross[1], 2) + math.Pow(cross[2], 2))
+	cosine := (a[0] * b[0]) + (a[1] * b[1]) + (a[2] * b[2])
+	return macros.ConvertRadianceToDegree(math.Atan2(sine, cosine))
+}
+
+func BuildSpatialIndex(points []SkyPoint, order int) (SpatialIndex, error) {
+	// Index of a set of points at a HEALPix order from 0 to MaxOrder
+	if order < 0 || order > MaxOrder {
+		return SpatialIndex{}, ErrInvalidOrder
+	}
+	index := SpatialIndex{Order: order, pointCount: len(points)}
+	index.pixels = make([]int64, len(points))
+	index.indices = make([]int, len(points))
+	index.vectors = make([][3]float64, len(points))
+	for i, point := range points {
+		index.indices[i] = i
+		index.pixels[i] = ConvertDecimalDegToHEALPixel(point.RADecimalHrs*15, point.DecDecimalDeg, order)
+	}
+	sort.Sort(byPixel(index))
+	for i, pointIndex := range index.indices {
+		index.vectors[i] = convertSkyPointToVector(points[pointIndex])
+	}
+	index.maxRadius = make([]float64, order+1)
+	for k := range index.maxRadius {
+		index.maxRadius[k] = CalculateHEALPixelMaxRadius(k)
+	}
+	return index, nil
+}
+
+func BuildStarIndex(stars []starcatalog.Star, order int) (SpatialIndex, error) {
+	// Index of the catalogue positions of a list of stars, bring them to a common epoch first for high accuracy
+	points := make([]SkyPoint, len(stars))
+	for i, star := range stars {
+		points[i] = SkyPoint{RADecimalHrs: star.RADecimalHrs, DecDecimalDeg: star.DecDecimalDeg}
+	}
+	return BuildSpatialIndex(points, order)
+}
+
+// byPixel sorts the entries of an index by pixel number, keeping the original order within a pixel
+type byPixel SpatialIndex
+
+func (index byPixel) Len() int { return len(index.pixels) }
+func (index byPixel) Less(i, j int) bool {
+	if index.pixels[i] != index.pixels[j] {
+		return index.pixels[i] < index.pixels[j]
+	}
+	return index.indices[i] < index.indices[j]
+}
+func (index byPixel) Swap(i, j int) {
+	index.pixels[i], index.pixels[j] = index.pixels[j], index.pixels[i]
+	index.indices[i], index.indices[j] = index.indices[j], index.indices[i]
+}
+
+func (index SpatialIndex) Len() int {
+	// Number of points in the index
+	return index.pointCount
+}
+
+func (index SpatialIndex) visitCandidates(mayIntersect func(centre [3]float64, radius float64) bool, visit func(entry int)) {
+	// Walks down the pixel hierarchy from the 12 base pixels, skipping the pixels that hold no point or cannot
+	// meet the region, and calls visit for every entry of the pixels of the index order that remain
+	var descend func(pixel int64, order int)
+	descend = func(pixel int64, order int) {
+		shift := 2 * (index.Order - order)
+		first := sort.Search(len(index.pixels), func(i int) bool { return index.pixels[i] >= pixel<<shift })
+		last := sort.Search(len(index.pixels), func(i int) bool { return index.pixels[i] >= (pixel+1)<<shift })
+		if first == last {
+			return
+		}
+		raDecimalDeg, decDecimalDeg := ConvertHEALPixelToDecimalDeg(pixel, order)
+		centre := convertSkyPointToVector(SkyPoint{RADecimalHrs: raDecimalDeg / 15, DecDecimalDeg: decDecimalDeg})
+		if !mayIntersect(centre, index.maxRadius[order]) {
+			return
+		}
+		if order == index.Order {
+			for entry := first; entry < last; entry++ {
+				visit(entry)
+			}
+			return
+		}
+		for child := 4 * pixel; child < (4*pixel)+4; child++ {
+			descend(child, order+1)
+		}
+	}
+	for pixel := int64(0); pixel < 12; pixel++ {
+		descend(pixel, 0)
+	}
+}
+
+func (index SpatialIndex) QueryCone(centre SkyPoint, radiusDecimalDeg float64) []Match {
+	// Points within a radius of a position, nearest first
+	target := convertSkyPointToVector(centre)
+	matches := []Match{}
+	index.visitCandidates(func(pixelCentre [3]float64, pixelRadius float64) bool {
+		return calculateAngle(pixelCentre, target) <= radiusDecimalDeg+pixelRadius
+	}, func(entry int) {
+		if distance := calculateAngle(index.vectors[entry], target); distance <= radiusDecimalDeg {
+			matches = append(matches, Match{Index: index.indices[entry], Distance: distance})
+		}
+	})
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Distance != matches[j].Distance {
+			return matches[i].Distance < matches[j].Distance
+		}
+		return matches[i].Index < matches[j].Index
+	})
+	return matches
+}
+
+func (index SpatialIndex) QueryNearest(centre SkyPoint, count int) []Match {
+	// The count points nearest to a position, nearest first, found by widening a cone search until it holds
+	// enough points
+	if count <= 0 || index.pointCount == 0 {
+		return []Match{}
+	}
+	radius := index.maxRadius[index.Order]
+	for {
+		matches := index.QueryCone(centre, radius)
+		if len(matches) >= count || radius >= 180 {
+			return matches[:min(count, len(matches))]
+		}
+		radius = math.Min(2*radius, 180)
+	}
+}
+
+func isRAInRange(raDecimalDeg, raMinDecimalDeg, raMaxDecimalDeg float64) bool {
+	// Whether a right ascension lies in the range going east from the minimum to the maximum, which may pass
+	// through 0h
+	span := macros.AdjustAngleRange(raMaxDecimalDeg-raMinDecimalDeg, 0, 360)
+	return macros.AdjustAngleRange(raDecimalDeg-raMinDecimalDeg, 0, 360) <= span
+}
+
+func (index SpatialIndex) QueryBox(raMinDecimalHrs, raMaxDecimalHrs, decMinDecimalDeg, decMaxDecimalDeg float64) []int {
+	// Points between two right ascensions, going east from the first to the second so that 23h to 1h passes
+	// through 0h, and between two declinations, as indices into the points in increasing order
+	raMin, raMax := raMinDecimalHrs*15, raMaxDecimalHrs*15
+	found := []int{}
+	index.visitCandidates(func(pixelCentre [3]float64, pixelRadius float64) bool {
+		// A circle around the pixel spans a range of right ascension that grows towards the poles
+		dec := macros.ConvertRadianceToDegree(math.Asin(pixelCentre[2]))
+		if dec+pixelRadius < decMinDecimalDeg || dec-pixelRadius > decMaxDecimalDeg {
+			return false
+		}
+		if math.Abs(dec)+pixelRadius >= 90 {
+			return true
+		}
+		ra := macros.ConvertRadianceToDegree(math.Atan2(pixelCentre[1], pixelCentre[0]))
+		halfWidth := macros.ConvertRadianceToDegree(math.Asin(math.Sin(macros.ConvertDegreesToRadiance(pixelRadius)) / math.Cos(macros.ConvertDegreesToRadiance(dec))))
+		if macros.AdjustAngleRange(raMax-raMin, 0, 360)+(2*halfWidth) >= 360 {
+			return true
+		}
+		return isRAInRange(ra, raMin-halfWidth, raMax+halfWidth)
+	}, func(entry int) {
+		vector := index.vectors[entry]
+		dec := macros.ConvertRadianceToDegree(math.Asin(vector[2]))
+		ra := macros.ConvertRadianceToDegree(math.Atan2(vector[1], vector[0]))
+		if dec >= decMinDecimalDeg && dec <= decMaxDecimalDeg && isRAInRange(ra, raMin, raMax) {
+			found = append(found, index.indices[entry])
+		}
+	})
+	sort.Ints(found)
+	return found
+}
+
+func (index SpatialIndex) QueryPolygon(vertices []SkyPoint) ([]int, error) {
+	// Points inside a convex spherical polygon whose sides are great circle arcs, such as the footprint of a
+	// detector, with the vertices in either order, as indices into the points in increasing order
+	if len(vertices) < 3 {
+		return nil, ErrInvalidPolygon
+	}
+
+	// Normals of the sides, turned to point inwards, and a cap around the polygon
+	centroid := [3]float64{}
+	corners := make([][3]float64, len(vertices))
+	for i, vertex := range vertices {
+		corners[i] = convertSkyPointToVector(vertex)
+		for k := range centroid {
+			centroid[k] += corners[i][k]
+		}
+	}
+	length := math.Sqrt(math.Pow(centroid[0], 2) + math.Pow(centroid[1], 2) + math.Pow(centroid[2], 2))
+	if length == 0 {
+		return nil, ErrInvalidPolygon
+	}
+	for k := range centroid {
+		centroid[k] /= length
+	}
+	normals := make([][3]float64, len(corners))
+	capRadius := 0.0
+	sign := 0.0
+	for i, a := range corners {
+		b := corners[(i+1)%len(corners)]
+		normals[i] = [3]float64{(a[1] * b[2]) - (a[2] * b[1]), (a[2] * b[0]) - (a[0] * b[2]), (a[0] * b[1]) - (a[1] * b[0])}
+		side := (normals[i][0] * centroid[0]) + (normals[i][1] * centroid[1]) + (normals[i][2] * centroid[2])
+		if sign == 0 {
+			sign = math.Copysign(1, side)
+		}
+		if side*sign <= 0 {
+			return nil, ErrInvalidPolygon
+		}
+		capRadius = math.Max(capRadius, calculateAngle(centroid, a))
+	}
+	for _, normal := range normals {
+		for _, corner := range corners {
+			if sign*((normal[0]*corner[0])+(normal[1]*corner[1])+(normal[2]*corner[2])) < -1e-12 {
+				return nil, ErrInvalidPolygon
+			}
+		}
+	}
+
+	found := []int{}
+	index.visitCandidates(func(pixelCentre [3]float64, pixelRadius float64) bool {
+		return calculateAngle(pixelCentre, centroid) <= capRadius+pixelRadius
+	}, func(entry int) {
+		vector := index.vectors[entry]
+		for _, normal := range normals {
+			if sign*((normal[0]*vector[0])+(normal[1]*vector[1])+(normal[2]*vector[2])) < 0 {
+				return
+			}
+		}
+		found = append(found, index.indices[entry])
+	})
+	sort.Ints(found)
+	return found, nil
+}
@@ -0,0 +1,313 @@
+package smallbody
+
+import (
+	"bufio"
+	"fmt"
+	datetime "go-astronomy/internal/dateTime"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Characters of the packed formats of the Minor Planet Center, their index is the value they stand for
+const packedDigits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+func unpackDigit(char byte) (int, error) {
+	value := strings.IndexByte(packedDigits, char)
+	if value < 0 {
+		return 0, fmt.Errorf("invalid packed character %q", char)
+	}
+	return value, nil
+}
+
+func unpackCentury(char byte) (int, error) {
+	// I, J and K stand for the 1800s, 1900s and 2000s
+	value, err := unpackDigit(char)
+	if err != nil || value < 10 {
+		return 0, fmt.Errorf("invalid packed century %q", char)
+	}
+	return value * 100, nil
+}
+
+func UnpackDesignation(packed string) (string, error) {
+	// Unpacks a number or a provisional designation of an asteroid or a comet, "A0345" is 100345, "J95X00A" is
+	// 1995 XA, "K07Tf8A" is 2007 TA418, "PLS2040" is 2040 P-L and "J95O010" is the comet designation 1995 O1.
+	packed = strings.TrimSpace(packed)
+	switch {
+	case len(packed) == 5 && packed[0] == '~':
+		// Numbers from 620000 onwards are written in base 62
+		number := 0
+		for i := 1; i < 5; i++ {
+			value, err := unpackDigit(packed[i])
+			if err != nil {
+				return "", err
+			}
+			number = (number * 62) + value
+		}
+		return strconv.Itoa(620000 + number), nil
+	case len(packed) == 5:
+		value, err := unpackDigit(packed[0])
+		if err != nil {
+			return "", err
+		}
+		number, err := strconv.Atoi(packed[1:])
+		if err != nil {
+			return "", fmt.Errorf("invalid packed number %q", packed)
+		}
+		return strconv.Itoa((value * 10000) + number), nil
+	case len(packed) == 4 && strings.Trim(packed, "0123456789") == "":
+		// Number of a periodic comet
+		number, _ := strconv.Atoi(packed)
+		return strconv.Itoa(number), nil
+	case len(packed) == 7 && packed[2] == 'S' && (packed[:2] == "PL" || packed[:2] == "T1" || packed[:2] == "T2" || packed[:2] == "T3"):
+		survey := map[string]string{"PL": "P-L", "T1": "T-1", "T2": "T-2", "T3": "T-3"}[packed[:2]]
+		return packed[3:] + " " + survey, nil
+	case len(packed) != 7:
+		return "", fmt.Errorf("invalid packed designation %q", packed)
+	}
+
+	century, err := unpackCentury(packed[0])
+	if err != nil {
+		return "", err
+	}
+	year, err := strconv.Atoi(packed[1:3])
+	if err != nil {
+		return "", fmt.Errorf("invalid packed designation %q", packed)
+	}
+	cycle, err := unpackDigit(packed[4])
+	if err != nil {
+		return "", err
+	}
+	cycleUnits, err := unpackDigit(packed[5])
+	if err != nil || cycleUnits > 9 {
+		return "", fmt.Errorf("invalid packed designation %q", packed)
+	}
+	count := (cycle * 10) + cycleUnits
+
+	// An upper case last letter is the second letter of an asteroid designation, otherwise it is a comet whose
+	// last character is 0 or the lower case letter of a fragment
+	lastChar := packed[6]
+	designation := strconv.Itoa(century+year) + " " + string(packed[3])
+	switch {
+	case lastChar >= 'A' && lastChar <= 'Z':
+		designation += string(lastChar)
+		if count > 0 {
+			designation += strconv.Itoa(count)
+		}
+	case lastChar == '0':
+		designation += strconv.Itoa(count)
+	case lastChar >= 'a' && lastChar <= 'z':
+		designation += strconv.Itoa(count) + "-" + strings.ToUpper(string(lastChar))
+	default:
+		return "", fmt.Errorf("invalid packed designation %q", packed)
+	}
+	return designation, nil
+}
+
+func UnpackEpoch(packed string) (float64, error) {
+	// Julian date of a packed epoch, "K205V" is 2020 May 31.0 TT
+	if len(packed) != 5 {
+		return 0, fmt.Errorf("invalid packed epoch %q", packed)
+	}
+	century, err := unpackCentury(packed[0])
+	if err != nil {
+		return 0, err
+	}
+	year, err := strconv.Atoi(packed[1:3])
+	if err != nil {
+		return 0, fmt.Errorf("invalid packed epoch %q", packed)
+	}
+	month, err := unpackDigit(packed[3])
+	if err != nil || month < 1 || month > 12 {
+		return 0, fmt.Errorf("invalid packed epoch %q", packed)
+	}
+	day, err := unpackDigit(packed[4])
+	if err != nil || day < 1 || day > 31 {
+		return 0, fmt.Errorf("invalid packed epoch %q", packed)
+	}
+	return datetime.ConvertGreenwichDateToJulianDate(float64(day), month, century+year), nil
+}
+
+func parseColumns(line string, first, last int) (float64, error) {
+	// Number in the columns first to last of a fixed width line, counted from 1 as in the MPC documentation
+	if len(line) < last {
+		return 0, fmt.Errorf("line too short for columns %d-%d", first, last)
+	}
+	field := strings.TrimSpace(line[first-1 : last])
+	value, err := strconv.ParseFloat(field, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q in columns %d-%d", field, first, last)
+	}
+	return value, nil
+}
+
+func ParseMPCORBLine(line string) (OrbitalElements, error) {
+	// Elements of an asteroid from one line of MPCORB.DAT. The magnitude parameters are left blank in the file
+	// for some objects, in which case G defaults to 0.15.
+	elements := OrbitalElements{}
+	if len(line) < 103 {
+		return elements, fmt.Errorf("line too short for an MPCORB record")
+	}
+	designation, err := UnpackDesignation(line[0:7])
+	if err != nil {
+		return elements, err
+	}
+	elements.Name = designation
+	if len(line) >= 194 && strings.TrimSpace(line[166:194]) != "" {
+		elements.Name = strings.TrimSpace(line[166:194])
+	}
+
+	elements.Epoch, err = UnpackEpoch(line[20:25])
+	if err != nil {
+		return elements, err
+	}
+	columns := []struct {
+		value       *float64
+		first, last int
+	}{
+		{&elements.MeanAnomaly, 27, 35},
+		{&elements.ArgumentOfPerihelion, 38, 46},
+		{&elements.AscendingNode, 49, 57},
+		{&elements.Inclination, 60, 68},
+		{&elements.Eccentricity, 71, 79},
+	}
+	for _, column := range columns {
+		if *column.value, err = parseColumns(line, column.first, column.last); err != nil {
+			return elements, err
+		}
+	}
+	a, err := parseColumns(line, 93, 103)
+	if err != nil {
+		return elements, err
+	}
+	elements.PerihelionDistance = a * (1 - elements.Eccentricity)
+
+	elements.SlopeParameter = 0.15
+	if strings.TrimSpace(line[8:13]) != "" {
+		if elements.AbsoluteMagnitude, err = parseColumns(line, 9, 13); err != nil {
+			return elements, err
+		}
+	}
+	if strings.TrimSpace(line[14:19]) != "" {
+		if elements.SlopeParameter, err = parseColumns(line, 15, 19); err != nil {
+			return elements, err
+		}
+	}
+	return elements, nil
+}
+
+func ParseCometElsLine(line string) (OrbitalElements, error) {
+	// Elements of a comet from one line of CometEls.txt. The file gives the magnitude law as
+	// m = H + 5 log delta + 2.5 G log r, so the comet parameter k is 2.5 G.
+	elements := OrbitalElements{IsComet: true}
+	if len(line) < 100 {
+		return elements, fmt.Errorf("line too short for a comet record")
+	}
+	designation := strings.TrimSpace(line[0:4]) + string(line[4])
+	if provisional := strings.TrimSpace(line[5:12]); provisional != "" {
+		unpacked, err := UnpackDesignation(provisional)
+		if err != nil {
+			return elements, err
+		}
+		designation = string(line[4]) + "/" + unpacked
+	}
+	elements.Name = designation
+	if len(line) > 102 {
+		if name := strings.TrimSpace(line[102:min(len(line), 158)]); name != "" {
+			elements.Name = name
+		}
+	}
+
+	year, err := parseColumns(line, 15, 18)
+	if err != nil {
+		return elements, err
+	}
+	month, err := parseColumns(line, 20, 21)
+	if err != nil {
+		return elements, err
+	}
+	day, err := parseColumns(line, 23, 29)
+	if err != nil {
+		return elements, err
+	}
+	elements.PerihelionTime = datetime.ConvertGreenwichDateToJulianDate(day, int(month), int(year))
+
+	columns := []struct {
+		value       *float64
+		first, last int
+	}{
+		{&elements.PerihelionDistance, 31, 39},
+		{&elements.Eccentricity, 42, 49},
+		{&elements.ArgumentOfPerihelion, 52, 59},
+		{&elements.AscendingNode, 62, 69},
+		{&elements.Inclination, 72, 79},
+	}
+	for _, column := range columns {
+		if *column.value, err = parseColumns(line, column.first, column.last); err != nil {
+			return elements, err
+		}
+	}
+	if strings.TrimSpace(line[91:100]) != "" {
+		if elements.AbsoluteMagnitude, err = parseColumns(line, 92, 95); err != nil {
+			return elements, err
+		}
+		if elements.SlopeParameter, err = parseColumns(line, 97, 100); err != nil {
+			return elements, err
+		}
+		elements.SlopeParameter *= 2.5
+	}
+	return elements, nil
+}
+
+func readElementsFile(path string, parseLine func(string) (OrbitalElements, error), hasHeader bool) ([]OrbitalElements, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	elementsList := []OrbitalElements{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 1024), 1024*1024)
+	isInHeader := hasHeader
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if isInHeader {
+			isInHeader = !strings.HasPrefix(line, "-----")
+			continue
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		elements, err := parseLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, lineNumber, err)
+		}
+		elementsList = append(elementsList, elements)
+	}
+	return elementsList, scanner.Err()
+}
+
+func ReadMPCORBFile(path string) ([]OrbitalElements, error) {
+	// Elements of all the asteroids of an MPCORB.DAT file, with or without its header which ends with a row of
+	// dashes. Files from other sources that start directly with the records are also accepted.
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	hasHeader := false
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if strings.HasPrefix(scanner.Text(), "-----") {
+			hasHeader = true
+			break
+		}
+	}
+	file.Close()
+	return readElementsFile(path, ParseMPCORBLine, hasHeader)
+}
+
+func ReadCometElsFile(path string) ([]OrbitalElements, error) {
+	// Elements of all the comets of a CometEls.txt file
+	return readElementsFile(path, ParseCometElsLine, false)
+}
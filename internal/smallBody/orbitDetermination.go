@@ -0,0 +1,411 @@
+package smallbody
+
+import (
+	"errors"
+	datetime "go-astronomy/internal/dateTime"
+	"go-astronomy/internal/macros"
+	"math"
+	"sort"
+)
+
+var ErrTooFewObservations = errors.New("orbit determination needs at least three observations")
+var ErrNoGaussSolution = errors.New("no orbit found through the observations")
+
+// Observation holds a geocentric astrometric position of a comet or an asteroid referred to the equator and
+// equinox of J2000.0 at a Julian date in UT.
+type Observation struct {
+	JulianDate    float64
+	RADecimalHrs  float64
+	DecDecimalDeg float64
+}
+
+// OrbitSolution holds the orbit fitted to a set of observations and the residuals observed minus computed of each
+// observation in arcseconds, the residual in right ascension multiplied by the cosine of the declination.
+type OrbitSolution struct {
+	Elements      OrbitalElements
+	RAResiduals   []float64
+	DecResiduals  []float64
+	RMSResidual   float64
+	NumIterations int
+}
+
+func dot(a, b [3]float64) float64 {
+	return (a[0] * b[0]) + (a[1] * b[1]) + (a[2] * b[2])
+}
+
+func cross(a, b [3]float64) [3]float64 {
+	return [3]float64{(a[1] * b[2]) - (a[2] * b[1]), (a[2] * b[0]) - (a[0] * b[2]), (a[0] * b[1]) - (a[1] * b[0])}
+}
+
+func norm(a [3]float64) float64 {
+	return math.Sqrt(dot(a, a))
+}
+
+func calculateLineOfSight(observation Observation) [3]float64 {
+	// Unit vector towards the observed position referred to the ecliptic and equinox of J2000.0
+	ra := macros.ConvertDegreesToRadiance(observation.RADecimalHrs * 15)
+	dec := macros.ConvertDegreesToRadiance(observation.DecDecimalDeg)
+	epsilon := macros.ConvertDegreesToRadiance(obliquityJ2000)
+	x, y, z := math.Cos(dec)*math.Cos(ra), math.Cos(dec)*math.Sin(ra), math.Sin(dec)
+	return [3]float64{x, (y * math.Cos(epsilon)) + (z * math.Sin(epsilon)), (-y * math.Sin(epsilon)) + (z * math.Cos(epsilon))}
+}
+
+func calculateStumpffFunctions(z float64) (C, S float64) {
+	switch {
+	case z > 1e-8:
+		return (1 - math.Cos(math.Sqrt(z))) / z, (math.Sqrt(z) - math.Sin(math.Sqrt(z))) / math.Pow(z, 1.5)
+	case z < -1e-8:
+		return (math.Cosh(math.Sqrt(-z)) - 1) / -z, (math.Sinh(math.Sqrt(-z)) - math.Sqrt(-z)) / math.Pow(-z, 1.5)
+	}
+	return 1.0 / 2, 1.0 / 6
+}
+
+func calculateLagrangeCoefficients(position, velocity [3]float64, tau float64) (f, g float64) {
+	// Exact f and g series of the two-body problem by the universal variable, with time in units of 1/k days so
+	// that the gravitational parameter of the Sun is 1
+	r0 := norm(position)
+	vr0 := dot(position, velocity) / r0
+	alpha := (2 / r0) - dot(velocity, velocity)
+
+	chi := math.Abs(alpha) * tau
+	for i := 0; i < 50; i++ {
+		z := alpha * chi * chi
+		C, S := calculateStumpffFunctions(z)
+		F := (r0 * vr0 * chi * chi * C) + ((1 - (alpha * r0)) * math.Pow(chi, 3) * S) + (r0 * chi) - tau
+		dF := (r0 * vr0 * chi * (1 - (z * S))) + ((1 - (alpha * r0)) * chi * chi * C) + r0
+		chi -= F / dF
+		if math.Abs(F/dF) < 1e-12 {
+			break
+		}
+	}
+	C, S := calculateStumpffFunctions(alpha * chi * chi)
+	return 1 - (chi * chi / r0 * C), tau - (math.Pow(chi, 3) * S)
+}
+
+func ConvertStateVectorToElements(position, velocity [3]float64, julianEphemerisDate float64) OrbitalElements {
+	// Orbital elements from a heliocentric position in AU and velocity in AU per day referred to the ecliptic
+	// and equinox of J2000.0. The position in the orbit is given by the time of perihelion.
+	v := [3]float64{velocity[0] / gaussianConstant, velocity[1] / gaussianConstant, velocity[2] / gaussianConstant}
+	r := norm(position)
+	h := cross(position, v)
+	node := [3]float64{-h[1], h[0], 0}
+	rv := dot(position, v)
+	eVector := [3]float64{}
+	for i := range eVector {
+		eVector[i] = ((dot(v, v) - (1 / r)) * position[i]) - (rv * v[i])
+	}
+	e := norm(eVector)
+
+	elements := OrbitalElements{Eccentricity: e}
+	elements.PerihelionDistance = dot(h, h) / (1 + e)
+	elements.Inclination = macros.ConvertRadianceToDegree(math.Acos(h[2] / norm(h)))
+
+	// Angles in the plane of the orbit are counted from the ascending node in the direction of motion. An orbit in
+	// the ecliptic takes its node on the x axis and a circular orbit its perihelion at the node.
+	if norm(node) < 1e-12*norm(h) {
+		node = [3]float64{1, 0, 0}
+	}
+	nodeUnit := [3]float64{node[0] / norm(node), node[1] / norm(node), 0}
+	normal := cross(h, nodeUnit)
+	normalUnit := [3]float64{normal[0] / norm(normal), normal[1] / norm(normal), normal[2] / norm(normal)}
+	angleFromNode := func(vector [3]float64) float64 {
+		return math.Atan2(dot(vector, normalUnit), dot(vector, nodeUnit))
+	}
+	elements.AscendingNode = macros.AdjustAngleRange(macros.ConvertRadianceToDegree(math.Atan2(nodeUnit[1], nodeUnit[0])), 0, 360)
+	omega := 0.0
+	if e > 1e-10 {
+		omega = angleFromNode(eVector)
+	}
+	elements.ArgumentOfPerihelion = macros.AdjustAngleRange(macros.ConvertRadianceToDegree(omega), 0, 360)
+	nu := math.Remainder(angleFromNode(position)-omega, 2*math.Pi)
+
+	// Time since perihelion in days from the anomaly appropriate to the type of orbit
+	q := elements.PerihelionDistance
+	t := 0.0
+	switch {
+	case math.Abs(e-1) < 1e-8:
+		D := math.Tan(nu / 2)
+		t = math.Sqrt(2*math.Pow(q, 3)) * (D + (math.Pow(D, 3) / 3))
+	case e < 1:
+		a := q / (1 - e)
+		E := 2 * math.Atan(math.Sqrt((1-e)/(1+e))*math.Tan(nu/2))
+		t = math.Pow(a, 1.5) * (E - (e * math.Sin(E)))
+	default:
+		a := q / (e - 1)
+		H := 2 * math.Atanh(math.Sqrt((e-1)/(e+1))*math.Tan(nu/2))
+		t = math.Pow(a, 1.5) * ((e * math.Sinh(H)) - H)
+	}
+	elements.PerihelionTime = julianEphemerisDate - (t / gaussianConstant)
+	return elements
+}
+
+func calculateGaussStateVectors(observations [3]Observation) [][2][3]float64 {
+	// Heliocentric position and velocity at the middle observation for every admissible root of Gauss's
+	// eighth degree equation, refined by iterating with the exact f and g series
+	times := [3]float64{}
+	rho := [3][3]float64{}
+	R := [3][3]float64{}
+	for i, observation := range observations {
+		times[i] = datetime.ConvertUniversalTimeToEphemerisTime(observation.JulianDate)
+		rho[i] = calculateLineOfSight(observation)
+		x, y, z := calculateHeliocentricPositionOfEarth(times[i])
+		R[i] = [3]float64{x, y, z}
+	}
+	tau1 := gaussianConstant * (times[0] - times[1])
+	tau3 := gaussianConstant * (times[2] - times[1])
+	tau := tau3 - tau1
+
+	p := [3][3]float64{cross(rho[1], rho[2]), cross(rho[0], rho[2]), cross(rho[0], rho[1])}
+	D0 := dot(rho[0], p[0])
+	D := [3][3]float64{}
+	for i := range D {
+		for j := range D[i] {
+			D[i][j] = dot(R[i], p[j])
+		}
+	}
+	A := ((-D[0][1] * tau3 / tau) + D[1][1] + (D[2][1] * tau1 / tau)) / D0
+	B := ((D[0][1] * ((tau3 * tau3) - (tau * tau)) * tau3 / tau) + (D[2][1] * ((tau * tau) - (tau1 * tau1)) * tau1 / tau)) / (6 * D0)
+	E := dot(R[1], rho[1])
+	R2sq := dot(R[1], R[1])
+	a := -((A * A) + (2 * A * E) + R2sq)
+	b := -2 * B * (A + E)
+	c := -B * B
+	polynomial := func(r float64) float64 {
+		return math.Pow(r, 8) + (a * math.Pow(r, 6)) + (b * math.Pow(r, 3)) + c
+	}
+
+	// Positive roots by scanning for changes of sign and bisecting
+	roots := []float64{}
+	for r := 0.01; r < 200; r *= 1.01 {
+		if polynomial(r)*polynomial(r*1.01) > 0 {
+			continue
+		}
+		roots = append(roots, macros.FindRoot(polynomial, r, r*1.01, 1e-14))
+	}
+
+	solutions := [][2][3]float64{}
+	for _, r2 := range roots {
+		r23 := math.Pow(r2, 3)
+		ranges := [3]float64{
+			((((6 * ((D[2][0] * tau1 / tau3) + (D[1][0] * tau / tau3)) * r23) + (D[2][0] * ((tau * tau) - (tau1 * tau1)) * tau1 / tau3)) / ((6 * r23) + ((tau * tau) - (tau3 * tau3)))) - D[0][0]) / D0,
+			A + (B / r23),
+			((((6 * ((D[0][2] * tau3 / tau1) - (D[1][2] * tau / tau1)) * r23) + (D[0][2] * ((tau * tau) - (tau3 * tau3)) * tau3 / tau1)) / ((6 * r23) + ((tau * tau) - (tau1 * tau1)))) - D[2][2]) / D0,
+		}
+		f1, g1 := 1-(tau1*tau1/(2*r23)), tau1-(math.Pow(tau1, 3)/(6*r23))
+		f3, g3 := 1-(tau3*tau3/(2*r23)), tau3-(math.Pow(tau3, 3)/(6*r23))
+
+		var position, velocity [3]float64
+		isValid := true
+		for iteration := 0; iteration < 100 && isValid; iteration++ {
+			positions := [3][3]float64{}
+			for i := range positions {
+				for j := 0; j < 3; j++ {
+					positions[i][j] = R[i][j] + (ranges[i] * rho[i][j])
+				}
+			}
+			denominator := (f1 * g3) - (f3 * g1)
+			position = positions[1]
+			for j := 0; j < 3; j++ {
+				velocity[j] = ((-f3 * positions[0][j]) + (f1 * positions[2][j])) / denominator
+			}
+
+			f1New, g1New := calculateLagrangeCoefficients(position, velocity, tau1)
+			f3New, g3New := calculateLagrangeCoefficients(position, velocity, tau3)
+			f1, g1, f3, g3 = (f1+f1New)/2, (g1+g1New)/2, (f3+f3New)/2, (g3+g3New)/2
+			c1 := g3 / ((f1 * g3) - (f3 * g1))
+			c3 := -g1 / ((f1 * g3) - (f3 * g1))
+			previous := ranges
+			ranges = [3]float64{
+				((-D[0][0]) + (D[1][0] / c1) - (D[2][0] * c3 / c1)) / D0,
+				((-c1 * D[0][1]) + D[1][1] - (c3 * D[2][1])) / D0,
+				((-c1 * D[0][2] / c3) + (D[1][2] / c3) - D[2][2]) / D0,
+			}
+			isValid = ranges[0] > 0 && ranges[1] > 0 && ranges[2] > 0 && !math.IsNaN(ranges[1])
+			if math.Abs(ranges[0]-previous[0])+math.Abs(ranges[1]-previous[1])+math.Abs(ranges[2]-previous[2]) < 1e-12 {
+				break
+			}
+		}
+		if !isValid {
+			continue
+		}
+		for j := 0; j < 3; j++ {
+			velocity[j] *= gaussianConstant
+		}
+
+		// Neighbouring roots may converge to the same orbit
+		isDuplicate := false
+		for _, solution := range solutions {
+			difference := [3]float64{solution[0][0] - position[0], solution[0][1] - position[1], solution[0][2] - position[2]}
+			isDuplicate = isDuplicate || norm(difference) < 1e-8
+		}
+		if !isDuplicate {
+			solutions = append(solutions, [2][3]float64{position, velocity})
+		}
+	}
+	return solutions
+}
+
+func CalculateGaussOrbits(observations [3]Observation) ([]OrbitalElements, error) {
+	// Preliminary orbits through three observations by the method of Gauss. There may be more than one solution,
+	// the differential correction of CalculateOrbitFromObservations chooses between them.
+	sort.Slice(observations[:], func(i, j int) bool { return observations[i].JulianDate < observations[j].JulianDate })
+	solutions := calculateGaussStateVectors(observations)
+	if len(solutions) == 0 {
+		return nil, ErrNoGaussSolution
+	}
+	julianEphemerisDate := datetime.ConvertUniversalTimeToEphemerisTime(observations[1].JulianDate)
+	orbits := []OrbitalElements{}
+	for _, solution := range solutions {
+		orbits = append(orbits, ConvertStateVectorToElements(solution[0], solution[1], julianEphemerisDate))
+	}
+	return orbits, nil
+}
+
+func calculateResiduals(elements OrbitalElements, observations []Observation) (residuals []float64) {
+	// Observed minus computed right ascension times the cosine of the declination and declination in arcseconds,
+	// alternating for each observation
+	for _, observation := range observations {
+		position := CalculatePosition(elements, datetime.ConvertUniversalTimeToEphemerisTime(observation.JulianDate))
+		deltaRA := math.Remainder((observation.RADecimalHrs-position.RADecimalHrs)*15, 360)
+		cosDec := math.Cos(macros.ConvertDegreesToRadiance(observation.DecDecimalDeg))
+		residuals = append(residuals, deltaRA*cosDec*3600, (observation.DecDecimalDeg-position.DecDecimalDeg)*3600)
+	}
+	return residuals
+}
+
+func solveLinearSystem(matrix [6][6]float64, vector [6]float64) ([6]float64, bool) {
+	// Gaussian elimination with partial pivoting
+	for column := 0; column < 6; column++ {
+		pivot := column
+		for row := column + 1; row < 6; row++ {
+			if math.Abs(matrix[row][column]) > math.Abs(matrix[pivot][column]) {
+				pivot = row
+			}
+		}
+		if matrix[pivot][column] == 0 {
+			return vector, false
+		}
+		matrix[column], matrix[pivot] = matrix[pivot], matrix[column]
+		vector[column], vector[pivot] = vector[pivot], vector[column]
+		for row := column + 1; row < 6; row++ {
+			factor := matrix[row][column] / matrix[column][column]
+			for k := column; k < 6; k++ {
+				matrix[row][k] -= factor * matrix[column][k]
+			}
+			vector[row] -= factor * vector[column]
+		}
+	}
+	solution := [6]float64{}
+	for row := 5; row >= 0; row-- {
+		sum := vector[row]
+		for k := row + 1; k < 6; k++ {
+			sum -= matrix[row][k] * solution[k]
+		}
+		solution[row] = sum / matrix[row][row]
+	}
+	return solution, true
+}
+
+func calculateStateVector(elements OrbitalElements, julianEphemerisDate float64) (state [6]float64) {
+	// Heliocentric position in AU and velocity in AU per day by numerical differentiation
+	const h = 0.01
+	x, y, z := CalculateHeliocentricPosition(elements, julianEphemerisDate)
+	x1, y1, z1 := CalculateHeliocentricPosition(elements, julianEphemerisDate-h)
+	x2, y2, z2 := CalculateHeliocentricPosition(elements, julianEphemerisDate+h)
+	return [6]float64{x, y, z, (x2 - x1) / (2 * h), (y2 - y1) / (2 * h), (z2 - z1) / (2 * h)}
+}
+
+func correctOrbit(elements OrbitalElements, julianEphemerisDate float64, observations []Observation) (OrbitalElements, int) {
+	// Differential correction of the state vector at julianEphemerisDate by least squares on the residuals of
+	// all the observations, with the partial derivatives taken numerically
+	state := calculateStateVector(elements, julianEphemerisDate)
+	toElements := func(state [6]float64) OrbitalElements {
+		return ConvertStateVectorToElements([3]float64{state[0], state[1], state[2]}, [3]float64{state[3], state[4], state[5]}, julianEphemerisDate)
+	}
+
+	iteration := 0
+	for ; iteration < 20; iteration++ {
+		residuals := calculateResiduals(toElements(state), observations)
+		partials := [6][]float64{}
+		for k := range state {
+			delta := 1e-7 * math.Max(1e-3, math.Abs(state[k]))
+			if k >= 3 {
+				delta = 1e-9
+			}
+			perturbed := state
+			perturbed[k] += delta
+			computed := calculateResiduals(toElements(perturbed), observations)
+			partials[k] = make([]float64, len(residuals))
+			for i := range residuals {
+				partials[k][i] = (residuals[i] - computed[i]) / delta
+			}
+		}
+
+		normalMatrix := [6][6]float64{}
+		normalVector := [6]float64{}
+		for j := 0; j < 6; j++ {
+			for k := 0; k < 6; k++ {
+				for i := range residuals {
+					normalMatrix[j][k] += partials[j][i] * partials[k][i]
+				}
+			}
+			for i := range residuals {
+				normalVector[j] += partials[j][i] * residuals[i]
+			}
+		}
+		correction, isSolved := solveLinearSystem(normalMatrix, normalVector)
+		if !isSolved {
+			break
+		}
+		size := 0.0
+		for k := range state {
+			state[k] += correction[k]
+			size += math.Abs(correction[k])
+		}
+		if size < 1e-11 {
+			break
+		}
+	}
+	return toElements(state), iteration + 1
+}
+
+func CalculateOrbitFromObservations(observations []Observation) (OrbitSolution, error) {
+	// Orbit from three or more observations: preliminary orbits by the method of Gauss from the first, middle
+	// and last observations, each improved by differential correction with all observations, keeping the one
+	// with the smallest residuals. The observations are taken as geocentric, topocentric astrometry of objects
+	// close to the Earth should be corrected for parallax first.
+	if len(observations) < 3 {
+		return OrbitSolution{}, ErrTooFewObservations
+	}
+	sorted := append([]Observation{}, observations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].JulianDate < sorted[j].JulianDate })
+	middle := sorted[len(sorted)/2]
+	preliminary, err := CalculateGaussOrbits([3]Observation{sorted[0], middle, sorted[len(sorted)-1]})
+	if err != nil {
+		return OrbitSolution{}, err
+	}
+
+	julianEphemerisDate := datetime.ConvertUniversalTimeToEphemerisTime(middle.JulianDate)
+	best := OrbitSolution{RMSResidual: math.Inf(1)}
+	for _, elements := range preliminary {
+		corrected, iterations := correctOrbit(elements, julianEphemerisDate, sorted)
+		residuals := calculateResiduals(corrected, observations)
+		solution := OrbitSolution{Elements: corrected, NumIterations: iterations}
+		sum := 0.0
+		for i := 0; i < len(residuals); i += 2 {
+			solution.RAResiduals = append(solution.RAResiduals, residuals[i])
+			solution.DecResiduals = append(solution.DecResiduals, residuals[i+1])
+			sum += math.Pow(residuals[i], 2) + math.Pow(residuals[i+1], 2)
+		}
+		solution.RMSResidual = math.Sqrt(sum / float64(len(residuals)))
+		if solution.RMSResidual < best.RMSResidual {
+			best = solution
+		}
+	}
+
+	// Every correction diverged when no solution has finite residuals
+	if math.IsInf(best.RMSResidual, 0) {
+		return OrbitSolution{}, ErrNoGaussSolution
+	}
+	return best, nil
+}
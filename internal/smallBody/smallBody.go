@@ -0,0 +1,210 @@
+package smallbody
+
+import (
+	"go-astronomy/internal/coords"
+	"go-astronomy/internal/macros"
+	"go-astronomy/internal/planets"
+	"math"
+)
+
+// Gaussian gravitational constant in radians and degrees per day
+const gaussianConstant = 0.01720209895
+const gaussianConstantDeg = 0.9856076686
+const obliquityJ2000 = 23.4392911
+const julianDateJ2000 = 2451545.0
+
+// OrbitalElements holds the osculating elements of a comet or an asteroid referred to the ecliptic and equinox
+// of J2000.0, angles in decimal degrees and distances in AU. The position in the orbit is given either by the
+// time of perihelion passage (a Julian ephemeris date) or, for elliptic orbits, by the mean anomaly at Epoch.
+// AbsoluteMagnitude and SlopeParameter are H and G for an asteroid, g and k for a comet.
+type OrbitalElements struct {
+	Name                 string
+	PerihelionDistance   float64
+	Eccentricity         float64
+	Inclination          float64
+	AscendingNode        float64
+	ArgumentOfPerihelion float64
+	PerihelionTime       float64
+	Epoch                float64
+	MeanAnomaly          float64
+	AbsoluteMagnitude    float64
+	SlopeParameter       float64
+	IsComet              bool
+}
+
+// SmallBodyPosition holds the astrometric place of a comet or an asteroid referred to the equator and equinox of
+// J2000.0, its distances from the Sun and the Earth in AU, elongation and phase angle in decimal degrees and the
+// predicted visual magnitude.
+type SmallBodyPosition struct {
+	RADecimalHrs         float64
+	DecDecimalDeg        float64
+	HeliocentricDistance float64
+	GeocentricDistance   float64
+	Elongation           float64
+	PhaseAngle           float64
+	Magnitude            float64
+}
+
+func (elements OrbitalElements) SemiMajorAxis() float64 {
+	// Semi-major axis in AU, negative for a hyperbolic orbit and infinite for a parabolic one
+	return elements.PerihelionDistance / (1 - elements.Eccentricity)
+}
+
+func solveHyperbolicKepler(M, e float64) (H float64) {
+	// Hyperbolic eccentric anomaly from e sinh H - H = M by Newton's method
+	H = math.Asinh(M / e)
+	for i := 0; i < 100; i++ {
+		deltaH := ((e * math.Sinh(H)) - H - M) / ((e * math.Cosh(H)) - 1)
+		H -= deltaH
+		if math.Abs(deltaH) < 1e-12 {
+			break
+		}
+	}
+	return H
+}
+
+func calculateNearParabolicMotion(q, e, t float64) (trueAnomaly, radiusAU float64) {
+	// Landgraf's method for orbits with an eccentricity close to 1 (Meeus chapter 35), t is the number of days
+	// since perihelion
+	const tolerance = 1e-9
+	q1 := gaussianConstant * math.Sqrt((1+e)/q) / (2 * q)
+	g := (1 - e) / (1 + e)
+	q2 := q1 * t
+	s := 2.0 / (3 * math.Abs(q2))
+	s = 2 / math.Tan(2*math.Atan(math.Cbrt(math.Tan(math.Atan(s)/2))))
+	if t < 0 {
+		s = -s
+	}
+
+	for l := 0; l < 50; l++ {
+		s0 := s
+		z := 1.0
+		y := math.Pow(s, 2)
+		g1 := -y * s
+		q3 := q2 + (2 * g * s * y / 3)
+		for z < 50 {
+			z++
+			g1 = -g1 * g * y
+			f := g1 * (z - ((z + 1) * g)) / ((2 * z) + 1)
+			q3 += f
+			if math.Abs(f) <= tolerance {
+				break
+			}
+		}
+		for i := 0; i < 100; i++ {
+			s1 := s
+			s = ((2 * math.Pow(s, 3) / 3) + q3) / (math.Pow(s, 2) + 1)
+			if math.Abs(s-s1) <= tolerance {
+				break
+			}
+		}
+		if math.Abs(s-s0) <= tolerance {
+			break
+		}
+	}
+
+	trueAnomaly = 2 * math.Atan(s)
+	radiusAU = q * (1 + e) / (1 + (e * math.Cos(trueAnomaly)))
+	return macros.ConvertRadianceToDegree(trueAnomaly), radiusAU
+}
+
+func CalculateTrueAnomalyAndRadius(elements OrbitalElements, julianEphemerisDate float64) (trueAnomaly, radiusAU float64) {
+	// True anomaly in decimal degrees, negative before perihelion, and radius vector in AU for elliptic, parabolic
+	// and hyperbolic orbits
+	q, e := elements.PerihelionDistance, elements.Eccentricity
+	t := julianEphemerisDate - elements.PerihelionTime
+
+	switch {
+	case e == 1:
+		// Barker's equation s^3 + 3s = W with s = tan(v/2)
+		W := 3 * gaussianConstant / math.Sqrt(2*math.Pow(q, 3)) * t
+		Y := math.Cbrt((W / 2) + math.Sqrt((math.Pow(W, 2)/4)+1))
+		s := Y - (1 / Y)
+		trueAnomaly = 2 * math.Atan(s)
+		radiusAU = q * (1 + math.Pow(s, 2))
+		return macros.ConvertRadianceToDegree(trueAnomaly), radiusAU
+	case e > 0.98 && e < 1.02 && elements.Epoch == 0:
+		return calculateNearParabolicMotion(q, e, t)
+	case e < 1:
+		a := elements.SemiMajorAxis()
+		M := gaussianConstantDeg / (a * math.Sqrt(a)) * t
+		if elements.Epoch != 0 {
+			M = elements.MeanAnomaly + (gaussianConstantDeg / (a * math.Sqrt(a)) * (julianEphemerisDate - elements.Epoch))
+		}
+		M = macros.ConvertDegreesToRadiance(macros.AdjustAngleRange(math.Mod(M, 360), 0, 360))
+		if M > math.Pi {
+			M -= 2 * math.Pi
+		}
+		E := macros.CalculateEccentricAnomaly(M, e)
+		trueAnomaly = 2 * math.Atan(math.Sqrt((1+e)/(1-e))*math.Tan(E/2))
+		radiusAU = a * (1 - (e * math.Cos(E)))
+		return macros.ConvertRadianceToDegree(trueAnomaly), radiusAU
+	}
+
+	a := -elements.SemiMajorAxis()
+	H := solveHyperbolicKepler(gaussianConstant/(a*math.Sqrt(a))*t, e)
+	trueAnomaly = 2 * math.Atan(math.Sqrt((e+1)/(e-1))*math.Tanh(H/2))
+	radiusAU = a * ((e * math.Cosh(H)) - 1)
+	return macros.ConvertRadianceToDegree(trueAnomaly), radiusAU
+}
+
+func CalculateHeliocentricPosition(elements OrbitalElements, julianEphemerisDate float64) (x, y, z float64) {
+	// Heliocentric rectangular coordinates in AU referred to the ecliptic and equinox of J2000.0
+	trueAnomaly, radiusAU := CalculateTrueAnomalyAndRadius(elements, julianEphemerisDate)
+	u := macros.ConvertDegreesToRadiance(trueAnomaly + elements.ArgumentOfPerihelion)
+	node := macros.ConvertDegreesToRadiance(elements.AscendingNode)
+	i := macros.ConvertDegreesToRadiance(elements.Inclination)
+
+	x = radiusAU * ((math.Cos(node) * math.Cos(u)) - (math.Sin(node) * math.Sin(u) * math.Cos(i)))
+	y = radiusAU * ((math.Sin(node) * math.Cos(u)) + (math.Cos(node) * math.Sin(u) * math.Cos(i)))
+	z = radiusAU * math.Sin(u) * math.Sin(i)
+	return x, y, z
+}
+
+func calculateHeliocentricPositionOfEarth(julianEphemerisDate float64) (x, y, z float64) {
+	// Heliocentric rectangular coordinates of the Earth referred to the ecliptic and equinox of J2000.0
+	longitude, latitude, radiusAU := planets.CalculateHeliocentricEclipticCoordinatesOfPlanet(julianEphemerisDate, "Earth")
+	longitude, latitude = coords.CalculateEclipticPrecessionDecimalDeg(longitude, latitude, julianEphemerisDate, julianDateJ2000)
+	l, b := macros.ConvertDegreesToRadiance(longitude), macros.ConvertDegreesToRadiance(latitude)
+	return radiusAU * math.Cos(b) * math.Cos(l), radiusAU * math.Cos(b) * math.Sin(l), radiusAU * math.Sin(b)
+}
+
+func CalculateMagnitude(elements OrbitalElements, heliocentricDistance, geocentricDistance, phaseAngle float64) float64 {
+	// Visual magnitude, m = g + 5 log delta + k log r for a comet and the H, G system for an asteroid
+	if elements.IsComet {
+		return elements.AbsoluteMagnitude + (5 * math.Log10(geocentricDistance)) + (elements.SlopeParameter * math.Log10(heliocentricDistance))
+	}
+	tanHalfPhase := math.Tan(macros.ConvertDegreesToRadiance(phaseAngle) / 2)
+	phi1 := math.Exp(-3.33 * math.Pow(tanHalfPhase, 0.63))
+	phi2 := math.Exp(-1.87 * math.Pow(tanHalfPhase, 1.22))
+	G := elements.SlopeParameter
+	return elements.AbsoluteMagnitude + (5 * math.Log10(heliocentricDistance*geocentricDistance)) - (2.5 * math.Log10(((1-G)*phi1)+(G*phi2)))
+}
+
+func CalculatePosition(elements OrbitalElements, julianEphemerisDate float64) SmallBodyPosition {
+	// Astrometric geocentric place corrected for light-time (Meeus chapter 33)
+	earthX, earthY, earthZ := calculateHeliocentricPositionOfEarth(julianEphemerisDate)
+	x, y, z, xi, eta, zeta, distanceAU := 0.0, 0.0, 0.0, 0.0, 0.0, 0.0, 0.0
+	for i := 0; i < 3; i++ {
+		x, y, z = CalculateHeliocentricPosition(elements, julianEphemerisDate-(planets.LightTimeDaysPerAU*distanceAU))
+		xi, eta, zeta = x-earthX, y-earthY, z-earthZ
+		distanceAU = math.Sqrt(math.Pow(xi, 2) + math.Pow(eta, 2) + math.Pow(zeta, 2))
+	}
+
+	position := SmallBodyPosition{}
+	lambda := macros.ConvertRadianceToDegree(math.Atan2(eta, xi))
+	beta := macros.ConvertRadianceToDegree(math.Atan2(zeta, math.Sqrt(math.Pow(xi, 2)+math.Pow(eta, 2))))
+	raDecimalDeg, decDecimalDeg := coords.ConvertEclipticDecimalDegToEquatorial(lambda, beta, obliquityJ2000)
+	position.RADecimalHrs = macros.ConvertDecimalDegressToDecimalHrs(macros.AdjustAngleRange(raDecimalDeg, 0, 360))
+	position.DecDecimalDeg = decDecimalDeg
+	position.HeliocentricDistance = math.Sqrt(math.Pow(x, 2) + math.Pow(y, 2) + math.Pow(z, 2))
+	position.GeocentricDistance = distanceAU
+
+	earthRadius := math.Sqrt(math.Pow(earthX, 2) + math.Pow(earthY, 2) + math.Pow(earthZ, 2))
+	r, delta := position.HeliocentricDistance, position.GeocentricDistance
+	position.Elongation = macros.ConvertRadianceToDegree(math.Acos((math.Pow(earthRadius, 2) + math.Pow(delta, 2) - math.Pow(r, 2)) / (2 * earthRadius * delta)))
+	position.PhaseAngle = macros.ConvertRadianceToDegree(math.Acos((math.Pow(r, 2) + math.Pow(delta, 2) - math.Pow(earthRadius, 2)) / (2 * r * delta)))
+	position.Magnitude = CalculateMagnitude(elements, r, delta, position.PhaseAngle)
+
+	return position
+}
@@ -0,0 +1,224 @@
+package starcatalog
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Returned for the records of the Bright Star Catalogue that were kept only to preserve the numbering, such as
+// novae and objects found not to be stars, which have no position
+var ErrMissingPosition = errors.New("record has no position")
+
+func parseColumns(line string, first, last int) (float64, error) {
+	// Number in the columns first to last of a fixed width line, counted from 1 as in the catalogue ReadMe files.
+	// Blank fields are zero.
+	if len(line) < last {
+		return 0, fmt.Errorf("line too short for columns %d-%d", first, last)
+	}
+	field := strings.TrimSpace(line[first-1 : last])
+	if field == "" {
+		return 0, nil
+	}
+	value, err := strconv.ParseFloat(field, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q in columns %d-%d", field, first, last)
+	}
+	return value, nil
+}
+
+func parseSexagesimal(field string) (float64, error) {
+	// Hours or degrees of a field such as "06 45 08.92" or "-16 42 58.0"
+	parts := strings.Fields(field)
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid sexagesimal value %q", field)
+	}
+	sign := 1.0
+	if strings.HasPrefix(parts[0], "-") {
+		sign = -1.0
+	}
+	value := 0.0
+	for i, part := range parts {
+		number, err := strconv.ParseFloat(strings.TrimLeft(part, "+-"), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid sexagesimal value %q", field)
+		}
+		value += number / [3]float64{1, 60, 3600}[i]
+	}
+	return sign * value, nil
+}
+
+func ParseYaleBrightStarRecord(line string) (Star, error) {
+	// Star of a record of the Yale Bright Star Catalogue, 5th revised edition (VizieR V/50, file catalog). The
+	// position is the FK5 one for equinox and epoch J2000.0, proper motions and parallax are converted to
+	// milliarcseconds.
+	star := Star{Epoch: 2000.0}
+	if len(line) < 170 {
+		return star, fmt.Errorf("line too short for a Bright Star Catalogue record")
+	}
+	line = fmt.Sprintf("%-197s", line)
+	if strings.TrimSpace(line[75:90]) == "" {
+		return star, ErrMissingPosition
+	}
+
+	// Identifiers
+	var err error
+	if star.HR, err = strconv.Atoi(strings.TrimSpace(line[0:4])); err != nil {
+		return star, fmt.Errorf("invalid HR number %q", line[0:4])
+	}
+	star.Name = strings.Join(strings.Fields(line[4:14]), " ")
+	if hd := strings.TrimSpace(line[25:31]); hd != "" {
+		if star.HD, err = strconv.Atoi(hd); err != nil {
+			return star, fmt.Errorf("invalid HD number %q", hd)
+		}
+	}
+
+	// Position
+	raH, errH := parseColumns(line, 76, 77)
+	raM, errM := parseColumns(line, 78, 79)
+	raS, errS := parseColumns(line, 80, 83)
+	if err = errors.Join(errH, errM, errS); err != nil {
+		return star, err
+	}
+	star.RADecimalHrs = raH + (raM / 60) + (raS / 3600)
+	decD, errD := parseColumns(line, 85, 86)
+	decM, errM := parseColumns(line, 87, 88)
+	decS, errS := parseColumns(line, 89, 90)
+	if err = errors.Join(errD, errM, errS); err != nil {
+		return star, err
+	}
+	star.DecDecimalDeg = decD + (decM / 60) + (decS / 3600)
+	if line[83] == '-' {
+		star.DecDecimalDeg = -star.DecDecimalDeg
+	}
+
+	// Photometry and spectrum
+	if star.VisualMagnitude, err = parseColumns(line, 103, 107); err != nil {
+		return star, err
+	}
+	if star.ColorIndexBV, err = parseColumns(line, 110, 114); err != nil {
+		return star, err
+	}
+	star.SpectralType = strings.TrimSpace(line[127:147])
+
+	// Motion, in arcseconds except the radial velocity
+	pmRA, errRA := parseColumns(line, 149, 154)
+	pmDec, errDec := parseColumns(line, 155, 160)
+	parallax, errParallax := parseColumns(line, 162, 166)
+	radialVelocity, errRV := parseColumns(line, 167, 170)
+	if err = errors.Join(errRA, errDec, errParallax, errRV); err != nil {
+		return star, err
+	}
+	star.ProperMotionRA = pmRA * 1000
+	star.ProperMotionDec = pmDec * 1000
+	star.Parallax = parallax * 1000
+	star.RadialVelocity = radialVelocity
+	return star, nil
+}
+
+func parseHipparcosField(fields []string, index int) (float64, error) {
+	// Number of the field Hn of a Hipparcos record, blank fields are zero
+	field := strings.TrimSpace(fields[index])
+	if field == "" {
+		return 0, nil
+	}
+	value, err := strconv.ParseFloat(field, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q in field H%d", field, index)
+	}
+	return value, nil
+}
+
+func ParseHipparcosRecord(line string) (Star, error) {
+	// Star of a record of the Hipparcos main catalogue (ESA 1997, VizieR I/239, file hip_main.dat) whose fields H0
+	// to H77 are separated by bars. The position is referred to the ICRS at epoch J1991.25. The few stars without
+	// an astrometric solution keep the rounded position of fields H3 and H4 and no proper motion.
+	star := Star{Epoch: 1991.25}
+	fields := strings.Split(line, "|")
+	if len(fields) < 77 {
+		return star, fmt.Errorf("expected 78 fields in a Hipparcos record, got %d", len(fields))
+	}
+
+	// Identifiers
+	var err error
+	if star.HIP, err = strconv.Atoi(strings.TrimSpace(fields[1])); err != nil {
+		return star, fmt.Errorf("invalid HIP number %q", fields[1])
+	}
+	if hd := strings.TrimSpace(fields[71]); hd != "" {
+		if star.HD, err = strconv.Atoi(hd); err != nil {
+			return star, fmt.Errorf("invalid HD number %q", hd)
+		}
+	}
+
+	// Position
+	if strings.TrimSpace(fields[8]) == "" {
+		if star.RADecimalHrs, err = parseSexagesimal(fields[3]); err != nil {
+			return star, err
+		}
+		if star.DecDecimalDeg, err = parseSexagesimal(fields[4]); err != nil {
+			return star, err
+		}
+	} else {
+		raDecimalDeg, errRA := parseHipparcosField(fields, 8)
+		decDecimalDeg, errDec := parseHipparcosField(fields, 9)
+		if err = errors.Join(errRA, errDec); err != nil {
+			return star, err
+		}
+		star.RADecimalHrs = raDecimalDeg / 15
+		star.DecDecimalDeg = decDecimalDeg
+	}
+
+	// Photometry, spectrum and motion, already in milliarcseconds
+	magnitude, errMagnitude := parseHipparcosField(fields, 5)
+	colorIndex, errColor := parseHipparcosField(fields, 37)
+	parallax, errParallax := parseHipparcosField(fields, 11)
+	pmRA, errPMRA := parseHipparcosField(fields, 12)
+	pmDec, errPMDec := parseHipparcosField(fields, 13)
+	if err = errors.Join(errMagnitude, errColor, errParallax, errPMRA, errPMDec); err != nil {
+		return star, err
+	}
+	star.VisualMagnitude, star.ColorIndexBV = magnitude, colorIndex
+	star.Parallax, star.ProperMotionRA, star.ProperMotionDec = parallax, pmRA, pmDec
+	star.SpectralType = strings.TrimSpace(fields[76])
+	return star, nil
+}
+
+func readCatalogFile(path string, parseLine func(string) (Star, error)) ([]Star, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	stars := []Star{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 1024), 1024*1024)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		star, err := parseLine(line)
+		if errors.Is(err, ErrMissingPosition) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, lineNumber, err)
+		}
+		stars = append(stars, star)
+	}
+	return stars, scanner.Err()
+}
+
+func ReadYaleBrightStarCatalog(path string) ([]Star, error) {
+	// Stars of the Yale Bright Star Catalogue file, the records without a position are left out
+	return readCatalogFile(path, ParseYaleBrightStarRecord)
+}
+
+func ReadHipparcosCatalog(path string) ([]Star, error) {
+	// Stars of the hip_main.dat file of the Hipparcos catalogue
+	return readCatalogFile(path, ParseHipparcosRecord)
+}
@@ -0,0 +1,83 @@
+package starcatalog
+
+import (
+	"go-astronomy/internal/macros"
+	"math"
+)
+
+// Kilometres per second in one AU per Julian year
+const kmPerSecondPerAUPerYear = 4.740470446
+const masPerRadian = 180 / math.Pi * 3600 * 1000
+
+// Smallest parallax in milliarcseconds used for space motion, stars with an unknown or negative parallax are
+// moved as if they were at 1000 kpc so that their radial velocity has no effect
+const minimumParallax = 0.001
+
+// Star holds a catalogue entry. The position is referred to the ICRS (FK5 J2000.0 for the Bright Star
+// Catalogue) at Epoch, a Julian year such as 1991.25 or 2000.0. Proper motions are in milliarcseconds per year,
+// the one in right ascension multiplied by the cosine of the declination, parallax in milliarcseconds and the
+// radial velocity in km/s, positive when receding. Numbers the catalogue does not give are zero.
+type Star struct {
+	Name            string
+	HR              int
+	HD              int
+	HIP             int
+	RADecimalHrs    float64
+	DecDecimalDeg   float64
+	Epoch           float64
+	VisualMagnitude float64
+	ColorIndexBV    float64
+	SpectralType    string
+	ProperMotionRA  float64
+	ProperMotionDec float64
+	Parallax        float64
+	RadialVelocity  float64
+}
+
+func PropagateStar(star Star, epochJulianYear float64) Star {
+	// Position, parallax, proper motions and radial velocity at another epoch by rigorous linear space motion
+	// (Hipparcos catalogue, volume 1, section 1.2.8), which includes the perspective acceleration of nearby stars
+	ra := macros.ConvertDegreesToRadiance(star.RADecimalHrs * 15)
+	dec := macros.ConvertDegreesToRadiance(star.DecDecimalDeg)
+	parallax := math.Max(star.Parallax, minimumParallax)
+	radialVelocity := star.RadialVelocity
+	if star.Parallax < minimumParallax {
+		radialVelocity = 0
+	}
+
+	// Unit vectors towards the star and towards increasing right ascension and declination
+	u := [3]float64{math.Cos(dec) * math.Cos(ra), math.Cos(dec) * math.Sin(ra), math.Sin(dec)}
+	p := [3]float64{-math.Sin(ra), math.Cos(ra), 0}
+	q := [3]float64{-math.Sin(dec) * math.Cos(ra), -math.Sin(dec) * math.Sin(ra), math.Cos(dec)}
+
+	// Position in AU and space velocity in AU per year
+	distance := masPerRadian / parallax
+	muRA := star.ProperMotionRA / masPerRadian
+	muDec := star.ProperMotionDec / masPerRadian
+	vr := radialVelocity / kmPerSecondPerAUPerYear
+	t := epochJulianYear - star.Epoch
+
+	position, velocity := [3]float64{}, [3]float64{}
+	for i := range position {
+		velocity[i] = (distance * ((muRA * p[i]) + (muDec * q[i]))) + (vr * u[i])
+		position[i] = (distance * u[i]) + (velocity[i] * t)
+	}
+	newDistance := math.Sqrt(math.Pow(position[0], 2) + math.Pow(position[1], 2) + math.Pow(position[2], 2))
+	newRA := math.Atan2(position[1], position[0])
+	newDec := math.Asin(position[2] / newDistance)
+	newU := [3]float64{position[0] / newDistance, position[1] / newDistance, position[2] / newDistance}
+	newP := [3]float64{-math.Sin(newRA), math.Cos(newRA), 0}
+	newQ := [3]float64{-math.Sin(newDec) * math.Cos(newRA), -math.Sin(newDec) * math.Sin(newRA), math.Cos(newDec)}
+
+	propagated := star
+	propagated.Epoch = epochJulianYear
+	propagated.RADecimalHrs = macros.AdjustAngleRange(macros.ConvertRadianceToDegree(newRA), 0, 360) / 15
+	propagated.DecDecimalDeg = macros.ConvertRadianceToDegree(newDec)
+	if star.Parallax >= minimumParallax {
+		propagated.Parallax = masPerRadian / newDistance
+		propagated.RadialVelocity = ((velocity[0] * newU[0]) + (velocity[1] * newU[1]) + (velocity[2] * newU[2])) * kmPerSecondPerAUPerYear
+	}
+	propagated.ProperMotionRA = ((velocity[0] * newP[0]) + (velocity[1] * newP[1]) + (velocity[2] * newP[2])) / newDistance * masPerRadian
+	propagated.ProperMotionDec = ((velocity[0] * newQ[0]) + (velocity[1] * newQ[1]) + (velocity[2] * newQ[2])) / newDistance * masPerRadian
+	return propagated
+}
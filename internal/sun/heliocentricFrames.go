@@ -0,0 +1,32 @@
+package sun
+
+import (
+	"go-astronomy/internal/coords"
+	vecmat "go-astronomy/internal/vecMat"
+)
+
+// Heliocentric coordinates referred to the mean ecliptic and equinox of J2000.0
+const FrameHeliocentricEclipticJ2000 coords.ReferenceFrame = "HeliocentricEclipticJ2000"
+
+// Heliocentric coordinates referred to the mean ecliptic and equinox of the date of the position
+const FrameHeliocentricEclipticOfDate coords.ReferenceFrame = "HeliocentricEclipticOfDate"
+
+func init() {
+	coords.RegisterFrame(FrameHeliocentricEclipticJ2000, calculateHeliocentricFrameDefinition(coords.CalculateICRSToEclipticJ2000Matrix))
+	coords.RegisterFrame(FrameHeliocentricEclipticOfDate, calculateHeliocentricFrameDefinition(coords.CalculateICRSToEclipticOfDateMatrix))
+}
+
+func CalculateGeometricPositionOfSun(julianEphemerisDate float64) vecmat.Vec3 {
+	// Geometric position of the Sun with respect to the centre of the Earth in AU along ICRS axes, from its true
+	// longitude and its distance, the latitude of the Sun never exceeding about 1"
+	trueLongitude, _, distanceAU := CalculateEclipticCoordinatesOfSun(julianEphemerisDate)
+	position := vecmat.ConvertSphericalDecimalDegToVec3(trueLongitude, 0).Scale(distanceAU)
+	return coords.CalculateICRSToEclipticOfDateMatrix(julianEphemerisDate).Transpose().Apply(position)
+}
+
+func calculateHeliocentricFrameDefinition(icrsToFrameMatrix func(julianEphemerisDate float64) vecmat.Mat3) coords.FrameDefinition {
+	// Ecliptic frame whose origin is moved from the centre of the Earth to that of the Sun
+	definition := coords.CalculateRotationFrameDefinition(icrsToFrameMatrix)
+	definition.Origin = CalculateGeometricPositionOfSun
+	return definition
+}
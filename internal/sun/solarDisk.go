@@ -0,0 +1,97 @@
+package sun
+
+import (
+	"go-astronomy/internal/coords"
+	datetime "go-astronomy/internal/dateTime"
+	"go-astronomy/internal/macros"
+	"math"
+)
+
+// SolarDiskEphemeris holds the orientation of the solar disk (Meeus chapter 29). P is the position angle of the
+// northern extremity of the axis of rotation, B0 and L0 the heliographic latitude and longitude of the centre of
+// the disk, all in decimal degrees. SemiDiameter is in arcseconds.
+type SolarDiskEphemeris struct {
+	P            float64
+	B0           float64
+	L0           float64
+	SemiDiameter float64
+}
+
+func CalculateSolarDiskEphemeris(julianEphemerisDate float64) SolarDiskEphemeris {
+	// Inclination of the solar equator on the ecliptic and longitude of its ascending node
+	I := macros.ConvertDegreesToRadiance(7.25)
+	K := macros.ConvertDegreesToRadiance(73.6667 + (1.3958333 * (julianEphemerisDate - 2396758.0) / 36525.0))
+	theta := macros.AdjustAngleRange(math.Mod((julianEphemerisDate-2398220.0)*360/25.38, 360), 0, 360)
+
+	trueLongitude, _, distanceAU := CalculateEclipticCoordinatesOfSun(julianEphemerisDate)
+	nutationInLong, _ := coords.CalculateNutationIAU2000B(julianEphemerisDate)
+	obliquity := macros.ConvertDegreesToRadiance(coords.CalculateTrueObliquity(julianEphemerisDate))
+	lambda := macros.ConvertDegreesToRadiance(trueLongitude - (20.4898 / 3600 / distanceAU))
+	lambdaApparent := lambda + macros.ConvertDegreesToRadiance(nutationInLong)
+
+	x := math.Atan(-math.Cos(lambdaApparent) * math.Tan(obliquity))
+	y := math.Atan(-math.Cos(lambda-K) * math.Tan(I))
+	eta := macros.ConvertRadianceToDegree(math.Atan2(-math.Sin(lambda-K)*math.Cos(I), -math.Cos(lambda-K)))
+
+	return SolarDiskEphemeris{
+		P:            macros.ConvertRadianceToDegree(x + y),
+		B0:           macros.ConvertRadianceToDegree(math.Asin(math.Sin(lambda-K) * math.Sin(I))),
+		L0:           macros.AdjustAngleRange(math.Mod(eta-theta, 360), 0, 360),
+		SemiDiameter: 959.63 / distanceAU,
+	}
+}
+
+func ConvertDiskPositionToHeliographic(ephemeris SolarDiskEphemeris, positionAngle, radialDistance float64) (latitude, longitude float64) {
+	// Heliographic coordinates of a point of the disk given by its position angle from the north point through
+	// east and its distance from the centre in units of the solar radius
+	semiDiameter := macros.ConvertDegreesToRadiance(ephemeris.SemiDiameter / 3600)
+	sigma := math.Asin(radialDistance) - (radialDistance * semiDiameter)
+	B0 := macros.ConvertDegreesToRadiance(ephemeris.B0)
+	chi := macros.ConvertDegreesToRadiance(ephemeris.P - positionAngle)
+
+	latitudeRad := math.Asin((math.Sin(B0) * math.Cos(sigma)) + (math.Cos(B0) * math.Sin(sigma) * math.Cos(chi)))
+	deltaL := math.Atan2(math.Sin(sigma)*math.Sin(chi), (math.Cos(B0)*math.Cos(sigma))-(math.Sin(B0)*math.Sin(sigma)*math.Cos(chi)))
+	latitude = macros.ConvertRadianceToDegree(latitudeRad)
+	longitude = macros.AdjustAngleRange(math.Mod(ephemeris.L0+macros.ConvertRadianceToDegree(deltaL), 360), 0, 360)
+	return latitude, longitude
+}
+
+func ConvertHeliographicToDiskPosition(ephemeris SolarDiskEphemeris, latitude, longitude float64) (positionAngle, radialDistance float64, isOnVisibleHemisphere bool) {
+	// Position angle and distance from the centre of the disk, in units of the solar radius, of a point given by
+	// its heliographic coordinates. Points on the far hemisphere are projected as if the Sun were transparent.
+	B0 := macros.ConvertDegreesToRadiance(ephemeris.B0)
+	B := macros.ConvertDegreesToRadiance(latitude)
+	deltaL := macros.ConvertDegreesToRadiance(longitude - ephemeris.L0)
+	semiDiameter := macros.ConvertDegreesToRadiance(ephemeris.SemiDiameter / 3600)
+
+	cosSigma := (math.Sin(B) * math.Sin(B0)) + (math.Cos(B) * math.Cos(B0) * math.Cos(deltaL))
+	sigma := math.Acos(math.Max(-1, math.Min(1, cosSigma)))
+	chi := math.Atan2(math.Cos(B)*math.Sin(deltaL), (math.Cos(B0)*math.Sin(B))-(math.Sin(B0)*math.Cos(B)*math.Cos(deltaL)))
+	positionAngle = macros.AdjustAngleRange(math.Mod(ephemeris.P-macros.ConvertRadianceToDegree(chi), 360), 0, 360)
+
+	// The limb as seen from the Earth lies slightly less than 90 degrees from the centre of the disk
+	radialDistance = math.Sin(sigma)
+	for i := 0; i < 5; i++ {
+		radialDistance = math.Sin(math.Min(sigma+(radialDistance*semiDiameter), math.Pi/2))
+	}
+	isOnVisibleHemisphere = sigma < (math.Pi/2)-semiDiameter
+	return positionAngle, radialDistance, isOnVisibleHemisphere
+}
+
+func CalculateCarringtonRotationStart(rotationNumber int) float64 {
+	// Julian date in UT at which the Carrington rotation begins, that is when L0 passes through 360 degrees
+	n := float64(rotationNumber)
+	julianEphemerisDate := 2398140.2270 + (27.2752316 * n)
+	M := macros.ConvertDegreesToRadiance(281.96 + (26.882476 * n))
+	julianEphemerisDate += (0.1454 * math.Sin(M)) - (0.0085 * math.Sin(2*M)) - (0.0141 * math.Cos(2*M))
+	return datetime.ConvertEphemerisTimeToUniversalTime(julianEphemerisDate)
+}
+
+func CalculateCarringtonRotationNumber(julianDate float64) float64 {
+	// Carrington rotation number including the fraction of the rotation elapsed, from the longitude of the centre
+	// of the disk
+	ephemeris := CalculateSolarDiskEphemeris(datetime.ConvertUniversalTimeToEphemerisTime(julianDate))
+	fraction := (360 - ephemeris.L0) / 360
+	approximate := 1690 + ((julianDate - 2444235.34) / 27.2753)
+	return math.Round(approximate-fraction) + fraction
+}
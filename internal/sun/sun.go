@@ -85,3 +85,34 @@ func CalculateTheEquationOfTime(GDay float64, GMonth, GYear int, UTHrs, UTMins i
 	eqHrs, eqMin, eqSec = datetime.ConvertDecimalHrsToHrsMinSec(datetime.ConvertHrsMinSecToDecimalHrs(raUTHrs, raUTMin, raUTSec, false, false) - 12)
 	return eqHrs, eqMin, eqSec
 }
+
+func CalculateEclipticCoordinatesOfSun(julianEphemerisDate float64) (trueLongitude, apparentLongitude, distanceAU float64) {
+	// Geocentric longitude of the Sun referred to the mean equinox of date (Meeus chapter 25)
+	T := (julianEphemerisDate - 2451545.0) / 36525.0
+	L0 := 280.46646 + (36000.76983 * T) + (0.0003032 * math.Pow(T, 2))
+	M := macros.ConvertDegreesToRadiance(357.52911 + (35999.05029 * T) - (0.0001537 * math.Pow(T, 2)))
+	e := 0.016708634 - (0.000042037 * T) - (0.0000001267 * math.Pow(T, 2))
+
+	C := ((1.914602 - (0.004817 * T) - (0.000014 * math.Pow(T, 2))) * math.Sin(M)) + ((0.019993 - (0.000101 * T)) * math.Sin(2*M)) + (0.000289 * math.Sin(3*M))
+	trueLongitude = macros.AdjustAngleRange(math.Mod(L0+C, 360), 0, 360)
+	V := M + macros.ConvertDegreesToRadiance(C)
+	distanceAU = (1.000001018 * (1 - math.Pow(e, 2))) / (1 + (e * math.Cos(V)))
+
+	// Correct for nutation and for the aberration of light
+	nutationInLong, _ := coords.CalculateNutationIAU2000B(julianEphemerisDate)
+	apparentLongitude = macros.AdjustAngleRange(trueLongitude-(20.4898/(3600*distanceAU))+nutationInLong, 0, 360)
+
+	return trueLongitude, apparentLongitude, distanceAU
+}
+
+func CalculateApparentPositionOfSun(julianEphemerisDate float64) (raHrs, raMin int, raSec float64, decDeg, decMin int, decSec, raDecimalHrs, decDecimalDeg, distanceAU float64) {
+	_, apparentLongitude, distanceAU := CalculateEclipticCoordinatesOfSun(julianEphemerisDate)
+
+	raDecimalDeg, decDecimalDeg := coords.ConvertEclipticDecimalDegToEquatorial(apparentLongitude, 0, coords.CalculateTrueObliquity(julianEphemerisDate))
+	raDecimalHrs = macros.ConvertDecimalDegressToDecimalHrs(raDecimalDeg)
+
+	raHrs, raMin, raSec = datetime.ConvertDecimalHrsToHrsMinSec(raDecimalHrs)
+	decDeg, decMin, decSec = macros.ConvertDecimalDegToDegMinSec(decDecimalDeg)
+
+	return raHrs, raMin, raSec, decDeg, decMin, decSec, raDecimalHrs, decDecimalDeg, distanceAU
+}
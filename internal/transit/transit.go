@@ -0,0 +1,163 @@
+package transit
+
+import (
+	"go-astronomy/internal/coords"
+	datetime "go-astronomy/internal/dateTime"
+	"go-astronomy/internal/macros"
+	"go-astronomy/internal/planets"
+	"math"
+)
+
+// Semi-diameters in arcseconds at a distance of 1 AU
+const sunSemiDiameter = 959.63
+const earthEquatorialRadiusKm = 6378.137
+const astronomicalUnitKm = 149597870.7
+
+var planetSemiDiameters = map[string]float64{
+	"Mercury": 3.36,
+	"Venus":   8.41,
+}
+
+// Transit holds the circumstances of a transit of Mercury or Venus across the Sun. Contact times are Julian
+// dates in UT, the internal contacts II and III are left as 0 for a grazing transit. MinimumSeparation is the
+// least distance between the centres of the planet and the Sun in arcseconds and PositionAngles are measured
+// from the north point of the solar disk through east. SunAltitudes is only filled in for local circumstances.
+type Transit struct {
+	PlanetName        string
+	ContactI          float64
+	ContactII         float64
+	GreatestTransit   float64
+	ContactIII        float64
+	ContactIV         float64
+	MinimumSeparation float64
+	PositionAngles    map[string]float64
+	SunAltitudes      map[string]float64
+}
+
+// transitGeometry returns the separation of the centres of the planet and the Sun, both semi-diameters and the
+// position angle of the planet, all in decimal degrees, and the altitude of the Sun
+type transitGeometry func(julianDate float64) (separation, sunRadius, planetRadius, positionAngle, sunAltitude float64)
+
+func calculateTransitGeometry(planetName string, isTopocentric bool, observer coords.Observer) transitGeometry {
+	pSin, pCos := coords.CalculateGeocentricParallax(observer.HeightFromSeaLevel, -observer.GeoLong, observer.GeoLatN)
+	return func(julianDate float64) (float64, float64, float64, float64, float64) {
+		julianEphemerisDate := datetime.ConvertUniversalTimeToEphemerisTime(julianDate)
+		// The Sun is taken from the same theory as the planet so that the errors of the Earth's orbit cancel
+		_, _, _, _, _, _, sunRAHrs, sunDec, sunDistanceAU := planets.CalculateApparentPositionOfPlanet(julianEphemerisDate, "Sun")
+		_, _, _, _, _, _, planetRAHrs, planetDec, planetDistanceAU := planets.CalculateApparentPositionOfPlanet(julianEphemerisDate, planetName)
+		sunRA, planetRA := sunRAHrs*15, planetRAHrs*15
+		sunDistance := sunDistanceAU * astronomicalUnitKm / earthEquatorialRadiusKm
+		planetDistance := planetDistanceAU * astronomicalUnitKm / earthEquatorialRadiusKm
+
+		localSiderealTime := coords.CalculateGreenwichApparentSiderealTime(julianDate) + observer.GeoLong
+		if isTopocentric {
+			sunRA, sunDec, sunDistance = coords.ConvertGeocentricToTopocentricDecimalDeg(sunRA, sunDec, sunDistance, localSiderealTime-sunRA, pSin, pCos)
+			planetRA, planetDec, planetDistance = coords.ConvertGeocentricToTopocentricDecimalDeg(planetRA, planetDec, planetDistance, localSiderealTime-planetRA, pSin, pCos)
+		}
+		sunAltitude, _ := coords.ConvertHourAngleDecimalDegToHorizon(localSiderealTime-sunRA, sunDec, observer.GeoLatN)
+
+		// The planet is only in front of the Sun when it is nearer to the Earth
+		separation := coords.CalculateAngularSeparationDecimalDeg(sunRA, sunDec, planetRA, planetDec)
+		if planetDistance > sunDistance {
+			separation = 180
+		}
+		sunRadius := sunSemiDiameter * (astronomicalUnitKm / earthEquatorialRadiusKm) / (3600 * sunDistance)
+		planetRadius := planetSemiDiameters[planetName] * (astronomicalUnitKm / earthEquatorialRadiusKm) / (3600 * planetDistance)
+		positionAngle := coords.CalculatePositionAngleDecimalDeg(sunRA, sunDec, planetRA, planetDec)
+
+		return separation, sunRadius, planetRadius, positionAngle, sunAltitude
+	}
+}
+
+func calculateTransitCircumstances(planetName string, geometry transitGeometry, tApproximate float64) (transit Transit, hasTransit bool) {
+	separationAt := func(julianDate float64) float64 {
+		separation, _, _, _, _ := geometry(julianDate)
+		return separation
+	}
+	externalContact := func(julianDate float64) float64 {
+		separation, sunRadius, planetRadius, _, _ := geometry(julianDate)
+		return separation - (sunRadius + planetRadius)
+	}
+	internalContact := func(julianDate float64) float64 {
+		separation, sunRadius, planetRadius, _, _ := geometry(julianDate)
+		return separation - (sunRadius - planetRadius)
+	}
+	findContact := func(contact func(float64) float64, start, end float64) float64 {
+		return macros.FindRoot(contact, start, end, 1e-9)
+	}
+
+	// Greatest transit is the instant of least separation
+	tGreatest := macros.FindMinimum(separationAt, tApproximate-0.5, tApproximate+0.5, 1e-9)
+	if externalContact(tGreatest) >= 0 {
+		return transit, false
+	}
+
+	separation, _, _, _, _ := geometry(tGreatest)
+	transit = Transit{
+		PlanetName:        planetName,
+		ContactI:          findContact(externalContact, tGreatest-0.5, tGreatest),
+		GreatestTransit:   tGreatest,
+		ContactIV:         findContact(externalContact, tGreatest, tGreatest+0.5),
+		MinimumSeparation: separation * 3600,
+		PositionAngles:    map[string]float64{},
+	}
+	if internalContact(tGreatest) < 0 {
+		transit.ContactII = findContact(internalContact, transit.ContactI, tGreatest)
+		transit.ContactIII = findContact(internalContact, tGreatest, transit.ContactIV)
+	}
+
+	contacts := map[string]float64{"I": transit.ContactI, "II": transit.ContactII, "Greatest": transit.GreatestTransit, "III": transit.ContactIII, "IV": transit.ContactIV}
+	for contact, julianDate := range contacts {
+		if julianDate != 0 {
+			_, _, _, transit.PositionAngles[contact], _ = geometry(julianDate)
+		}
+	}
+	return transit, true
+}
+
+func CalculateTransits(planetName string, startJulianDate, endJulianDate float64) []Transit {
+	// Geocentric transits of Mercury or Venus between two Julian dates in UT. Inferior conjunctions are found
+	// from the daily separation of the planet from the Sun.
+	geometry := calculateTransitGeometry(planetName, false, coords.Observer{})
+	separations := []float64{}
+	for julianDate := startJulianDate - 1; julianDate <= endJulianDate+1; julianDate++ {
+		separation, _, _, _, _ := geometry(julianDate)
+		separations = append(separations, separation)
+	}
+
+	transits := []Transit{}
+	for i := 1; i < len(separations)-1; i++ {
+		if separations[i] > 2 || separations[i] > separations[i-1] || separations[i] > separations[i+1] {
+			continue
+		}
+		transit, hasTransit := calculateTransitCircumstances(planetName, geometry, startJulianDate-1+float64(i))
+		if hasTransit && transit.GreatestTransit >= startJulianDate && transit.GreatestTransit <= endJulianDate {
+			transits = append(transits, transit)
+		}
+	}
+	return transits
+}
+
+func CalculateLocalTransitCircumstances(transit Transit, observer coords.Observer) (localTransit Transit, isVisible bool) {
+	// Topocentric contacts of a transit found by CalculateTransits. The transit is visible if the Sun is above
+	// the horizon at some instant of it.
+	geometry := calculateTransitGeometry(transit.PlanetName, true, observer)
+	localTransit, hasTransit := calculateTransitCircumstances(transit.PlanetName, geometry, transit.GreatestTransit)
+	if !hasTransit {
+		return localTransit, false
+	}
+
+	localTransit.SunAltitudes = map[string]float64{}
+	for contact := range localTransit.PositionAngles {
+		julianDate := map[string]float64{"I": localTransit.ContactI, "II": localTransit.ContactII, "Greatest": localTransit.GreatestTransit, "III": localTransit.ContactIII, "IV": localTransit.ContactIV}[contact]
+		_, _, _, _, localTransit.SunAltitudes[contact] = geometry(julianDate)
+	}
+
+	const step = 5.0 / 1440
+	for julianDate := localTransit.ContactI; julianDate < localTransit.ContactIV+step; julianDate += step {
+		if _, _, _, _, sunAltitude := geometry(math.Min(julianDate, localTransit.ContactIV)); sunAltitude > 0 {
+			return localTransit, true
+		}
+	}
+	return localTransit, false
+}
@@ -0,0 +1,140 @@
+package vecmat
+
+import "math"
+
+// Vec3 is a Cartesian vector, a unit vector when it gives a direction on the sky
+type Vec3 [3]float64
+
+// Mat3 is a 3x3 matrix acting on column vectors. Rotation matrices turn the frame rather than the vector, so
+// CalculateRotationZ(90) takes the direction at longitude 90 degrees to longitude 0.
+type Mat3 [3][3]float64
+
+// Identity is the matrix leaving every vector unchanged
+var Identity = Mat3{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+
+func ConvertSphericalDecimalDegToVec3(longitude, latitude float64) Vec3 {
+	// Unit vector of a longitude and latitude in decimal degrees, the x axis pointing to longitude 0 and the z axis
+	// to the pole
+	longitudeRad, latitudeRad := longitude*math.Pi/180, latitude*math.Pi/180
+	return Vec3{math.Cos(latitudeRad) * math.Cos(longitudeRad), math.Cos(latitudeRad) * math.Sin(longitudeRad), math.Sin(latitudeRad)}
+}
+
+func (v Vec3) ConvertToSphericalDecimalDeg() (longitude, latitude float64) {
+	// Longitude from 0 to 360 degrees and latitude of the direction of a vector of any length, atan2 keeping both
+	// in the right quadrant and the latitude accurate next to the poles
+	longitude = math.Atan2(v[1], v[0]) * 180 / math.Pi
+	if longitude < 0 {
+		longitude += 360
+	}
+	latitude = math.Atan2(v[2], math.Hypot(v[0], v[1])) * 180 / math.Pi
+	return longitude, latitude
+}
+
+func (v Vec3) Add(w Vec3) Vec3 {
+	return Vec3{v[0] + w[0], v[1] + w[1], v[2] + w[2]}
+}
+
+func (v Vec3) Subtract(w Vec3) Vec3 {
+	return Vec3{v[0] - w[0], v[1] - w[1], v[2] - w[2]}
+}
+
+func (v Vec3) Scale(factor float64) Vec3 {
+	return Vec3{v[0] * factor, v[1] * factor, v[2] * factor}
+}
+
+func (v Vec3) Dot(w Vec3) float64 {
+	return (v[0] * w[0]) + (v[1] * w[1]) + (v[2] * w[2])
+}
+
+func (v Vec3) Cross(w Vec3) Vec3 {
+	return Vec3{(v[1] * w[2]) - (v[2] * w[1]), (v[2] * w[0]) - (v[0] * w[2]), (v[0] * w[1]) - (v[1] * w[0])}
+}
+
+func (v Vec3) Length() float64 {
+	return math.Sqrt(v.Dot(v))
+}
+
+func (v Vec3) Normalize() Vec3 {
+	// Unit vector in the direction of v, the zero vector staying zero
+	length := v.Length()
+	if length == 0 {
+		return v
+	}
+	return v.Scale(1 / length)
+}
+
+func (v Vec3) CalculateAngularSeparationDecimalDeg(w Vec3) float64 {
+	// Angle between two directions in decimal degrees, accurate for small and for nearly opposite directions alike
+	return math.Atan2(v.Cross(w).Length(), v.Dot(w)) * 180 / math.Pi
+}
+
+func CalculateRotationX(angleDecimalDeg float64) Mat3 {
+	// Rotation of the frame about the x axis, anticlockwise seen from +x
+	s, c := math.Sincos(angleDecimalDeg * math.Pi / 180)
+	return Mat3{{1, 0, 0}, {0, c, s}, {0, -s, c}}
+}
+
+func CalculateRotationY(angleDecimalDeg float64) Mat3 {
+	s, c := math.Sincos(angleDecimalDeg * math.Pi / 180)
+	return Mat3{{c, 0, -s}, {0, 1, 0}, {s, 0, c}}
+}
+
+func CalculateRotationZ(angleDecimalDeg float64) Mat3 {
+	s, c := math.Sincos(angleDecimalDeg * math.Pi / 180)
+	return Mat3{{c, s, 0}, {-s, c, 0}, {0, 0, 1}}
+}
+
+func ConvertRotationVectorToMat3(rotation Vec3) Mat3 {
+	// Rotation of the frame about the axis of a vector by its length in decimal degrees, the form in which small
+	// rotations between catalogue frames are published
+	angle := rotation.Length()
+	if angle == 0 {
+		return Identity
+	}
+	axis := rotation.Scale(1 / angle)
+	s, c := math.Sincos(angle * math.Pi / 180)
+	matrix := Mat3{}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			matrix[i][j] = axis[i] * axis[j] * (1 - c)
+		}
+		matrix[i][i] += c
+	}
+	matrix[0][1] += axis[2] * s
+	matrix[0][2] -= axis[1] * s
+	matrix[1][0] -= axis[2] * s
+	matrix[1][2] += axis[0] * s
+	matrix[2][0] += axis[1] * s
+	matrix[2][1] -= axis[0] * s
+	return matrix
+}
+
+func (a Mat3) Multiply(b Mat3) Mat3 {
+	// Matrix product a·b, the transformation applying b first and then a
+	product := Mat3{}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			product[i][j] = (a[i][0] * b[0][j]) + (a[i][1] * b[1][j]) + (a[i][2] * b[2][j])
+		}
+	}
+	return product
+}
+
+func (a Mat3) Then(b Mat3) Mat3 {
+	// Transformation applying a first and then b, so that chains read in the order the frames are visited
+	return b.Multiply(a)
+}
+
+func (a Mat3) Transpose() Mat3 {
+	// Inverse of a rotation matrix, the transformation back to the original frame
+	return Mat3{{a[0][0], a[1][0], a[2][0]}, {a[0][1], a[1][1], a[2][1]}, {a[0][2], a[1][2], a[2][2]}}
+}
+
+func (a Mat3) Apply(v Vec3) Vec3 {
+	return Vec3{Vec3(a[0]).Dot(v), Vec3(a[1]).Dot(v), Vec3(a[2]).Dot(v)}
+}
+
+func (a Mat3) ApplyDecimalDeg(longitude, latitude float64) (rotatedLongitude, rotatedLatitude float64) {
+	// Longitude and latitude in decimal degrees carried into the frame of the matrix
+	return a.Apply(ConvertSphericalDecimalDegToVec3(longitude, latitude)).ConvertToSphericalDecimalDeg()
+}
@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"errors"
+	"go-astronomy/internal/coords"
+	"math"
+	"testing"
+)
+
+func TestCalculateAirmass(t *testing.T) {
+	const tolerance = 0.001 // Define an acceptable error range
+
+	// Each model at a zenith distance of 60 degrees and at the horizon
+	expected := map[coords.AirmassModel][2]float64{
+		coords.AirmassSecant:      {2.0, 0},
+		coords.AirmassHardie:      {1.9945, 0},
+		coords.AirmassKastenYoung: {1.9943, 37.92},
+		coords.AirmassPickering:   {1.9932, 38.75},
+	}
+	for model, airmasses := range expected {
+		if airmass, err := coords.CalculateAirmass(30, model); err != nil || math.Abs(airmass-airmasses[0]) > tolerance {
+			t.Fatalf("Error while Calculating Airmass. Required: %f Got: %f (%v)", airmasses[0], airmass, err)
+		}
+		airmass, err := coords.CalculateAirmass(0, model)
+		if airmasses[1] == 0 && !errors.Is(err, coords.ErrBelowHorizon) || airmasses[1] != 0 && math.Abs(airmass-airmasses[1]) > 0.01 {
+			t.Fatalf("Error while Calculating Airmass. Required: %f Got: %f (%v)", airmasses[1], airmass, err)
+		}
+	}
+
+	if airmass, _ := coords.CalculateAirmass(90, coords.AirmassKastenYoung); math.Abs(airmass-1) > tolerance {
+		t.Fatalf("Error while Calculating Airmass. Required: %f Got: %f", 1.0, airmass)
+	}
+	// The Hardie polynomial turns over near the horizon, where the other models keep growing
+	if _, err := coords.CalculateAirmass(3, coords.AirmassHardie); !errors.Is(err, coords.ErrAirmassOutOfRange) {
+		t.Fatalf("Error while Calculating Airmass. Required: %v Got: %v", coords.ErrAirmassOutOfRange, err)
+	}
+	if _, err := coords.CalculateExtinction(0.5, 550, coords.CalculateStandardAtmosphere(0), coords.AirmassHardie); !errors.Is(err, coords.ErrAirmassOutOfRange) {
+		t.Fatalf("Error while Calculating Extinction. Required: %v Got: %v", coords.ErrAirmassOutOfRange, err)
+	}
+	if airmass, err := coords.CalculateAirmass(5, coords.AirmassHardie); err != nil || math.Abs(airmass-10.21) > 0.01 {
+		t.Fatalf("Error while Calculating Airmass. Required: %f Got: %f (%v)", 10.21, airmass, err)
+	}
+	if airmass, err := coords.CalculateAirmass(3, coords.AirmassKastenYoung); err != nil || math.Abs(airmass-15.15) > 0.01 {
+		t.Fatalf("Error while Calculating Airmass. Required: %f Got: %f (%v)", 15.15, airmass, err)
+	}
+	if _, err := coords.CalculateAirmass(-1, coords.AirmassPickering); !errors.Is(err, coords.ErrBelowHorizon) {
+		t.Fatalf("Error while Calculating Airmass. Required: %v Got: %v", coords.ErrBelowHorizon, err)
+	}
+	if _, err := coords.CalculateAirmass(45, coords.AirmassModel(9)); !errors.Is(err, coords.ErrUnknownAirmassModel) {
+		t.Fatalf("Error while Calculating Airmass. Required: %v Got: %v", coords.ErrUnknownAirmassModel, err)
+	}
+}
+
+func TestCalculateExtinction(t *testing.T) {
+	// Rayleigh optical depth of 0.0973 at 550 nm at sea level, falling with the pressure at a mountain site
+	seaLevel := coords.CalculateStandardAtmosphere(0)
+	coefficients := coords.CalculateExtinctionCoefficients(550, seaLevel)
+	if math.Abs(coefficients.Rayleigh-(1.0857*0.0973)) > 0.001 || math.Abs(coefficients.Aerosol-(1.0857*0.1)) > 0.001 || math.Abs(coefficients.Ozone-(1.0857*0.0255)) > 0.001 {
+		t.Fatalf("Error while Calculating Extinction Coefficients. Required: %f %f %f Got: %f %f %f", 1.0857*0.0973, 1.0857*0.1, 1.0857*0.0255, coefficients.Rayleigh, coefficients.Aerosol, coefficients.Ozone)
+	}
+	mountain := coords.CalculateStandardAtmosphere(4200)
+	if math.Abs(mountain.Pressure-600.5) > 1 || coords.CalculateExtinctionCoefficients(550, mountain).CalculateTotal() >= coefficients.CalculateTotal() {
+		t.Fatalf("Error while Calculating Standard Atmosphere. Required: %f Got: %f", 600.5, mountain.Pressure)
+	}
+
+	// Blue light is dimmed more than red, and everything more at low altitude
+	blue, _ := coords.CalculateExtinction(40, 440, seaLevel, coords.AirmassKastenYoung)
+	red, _ := coords.CalculateExtinction(40, 650, seaLevel, coords.AirmassKastenYoung)
+	low, _ := coords.CalculateExtinction(5, 650, seaLevel, coords.AirmassKastenYoung)
+	if blue <= red || low <= red {
+		t.Fatalf("Error while Calculating Extinction. Required: %f > %f < %f", blue, red, low)
+	}
+
+	// A body just below the geometric horizon is still seen by refraction
+	if _, err := coords.CalculateExtinction(-0.3, 550, seaLevel, coords.AirmassPickering); err != nil {
+		t.Fatalf("Error while Calculating Extinction. Required: %v Got: %v", nil, err)
+	}
+	if refraction := coords.CalculateRefractionDecimalDeg(0, 10, 1010); math.Abs(refraction-0.57) > 0.03 {
+		t.Fatalf("Error while Calculating Refraction. Required: %f Got: %f", 0.57, refraction)
+	}
+}
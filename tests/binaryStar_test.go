@@ -0,0 +1,43 @@
+package tests
+
+import (
+	binarystar "go-astronomy/internal/binaryStar"
+	"math"
+	"testing"
+)
+
+// Orbit of eta Coronae Borealis (Meeus examples 57.a and 57.b)
+var etaCoronaeBorealis = binarystar.BinaryStarElements{
+	Period:               41.623,
+	PeriastronEpoch:      1934.008,
+	Eccentricity:         0.2763,
+	SemiMajorAxis:        0.907,
+	Inclination:          59.025,
+	AscendingNode:        23.717,
+	ArgumentOfPeriastron: 219.907,
+}
+
+func TestCalculateBinaryStarPosition(t *testing.T) {
+	const tolerance = 0.1 // Define an acceptable error range
+
+	position := binarystar.CalculateBinaryStarPosition(etaCoronaeBorealis, 1980.0)
+	if math.Abs(position.PositionAngle-318.4) > tolerance || math.Abs(position.Separation-0.411) > tolerance/100 {
+		t.Fatalf("Error while Calculating Binary Star Position. Required: %f %f Got: %f %f", 318.4, 0.411, position.PositionAngle, position.Separation)
+	}
+}
+
+func TestCalculateApparentOrbit(t *testing.T) {
+	// The first point is periastron
+	const tolerance = 0.001 // Define an acceptable error range
+
+	points := binarystar.CalculateApparentOrbit(etaCoronaeBorealis, 36)
+	periastron := binarystar.CalculateBinaryStarPosition(etaCoronaeBorealis, etaCoronaeBorealis.PeriastronEpoch)
+	if len(points) != 36 || math.Abs(points[0].Separation-periastron.Separation) > tolerance || math.Abs(points[0].PositionAngle-periastron.PositionAngle) > tolerance {
+		t.Fatalf("Error while Calculating Apparent Orbit. Required: %f %f Got: %f %f", periastron.PositionAngle, periastron.Separation, points[0].PositionAngle, points[0].Separation)
+	}
+
+	// Meeus example 57.b
+	if eccentricity := binarystar.CalculateApparentOrbitEccentricity(etaCoronaeBorealis); math.Abs(eccentricity-0.860) > tolerance {
+		t.Fatalf("Error while Calculating Apparent Orbit Eccentricity. Required: %f Got: %f", 0.860, eccentricity)
+	}
+}
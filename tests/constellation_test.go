@@ -0,0 +1,122 @@
+package tests
+
+import (
+	"errors"
+	"go-astronomy/internal/constellation"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// The first lines of Roman's table, which cover the north polar cap, closed by a line giving the rest of the sky to
+// Octans as the last line of the full table does for the south polar cap
+const boundaryTableExcerpt = ` 0.0000 24.0000  88.0000 UMI
+ 8.0000 14.5000  86.5000 UMI
+21.0000 23.0000  86.1667 UMI
+18.0000 21.0000  86.0000 UMI
+ 0.0000  8.0000  85.0000 CEP
+ 0.0000 24.0000 -90.0000 OCT
+`
+
+func readBoundaryTableExcerpt(t *testing.T) constellation.BoundaryTable {
+	path := filepath.Join(t.TempDir(), "data.dat")
+	if err := os.WriteFile(path, []byte(boundaryTableExcerpt), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	table, err := constellation.ReadBoundaryTable(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return table
+}
+
+func TestFindConstellationInfo(t *testing.T) {
+	const tolerance = 0.05 // Define an acceptable error range
+
+	info, err := constellation.FindConstellationInfo("cma")
+	if err != nil || info.Name != "Canis Major" || info.Genitive != "Canis Majoris" || info.Area != 380.118 {
+		t.Fatalf("Error while Finding Constellation. Required: %s %s Got: %s %s (%v)", "Canis Major", "Canis Majoris", info.Name, info.Genitive, err)
+	}
+	if _, err := constellation.FindConstellationInfo("Xyz"); !errors.Is(err, constellation.ErrUnknownConstellation) {
+		t.Fatalf("Error while Finding Constellation. Required: %v Got: %v", constellation.ErrUnknownConstellation, err)
+	}
+
+	// The areas add up to the whole sky
+	total := 0.0
+	for _, info := range constellation.Constellations {
+		total += info.Area
+	}
+	sky := 4 * math.Pi * math.Pow(180/math.Pi, 2)
+	if len(constellation.Constellations) != 88 || math.Abs(total-sky) > tolerance {
+		t.Fatalf("Error while Finding Constellation. Required: %d %f Got: %d %f", 88, sky, len(constellation.Constellations), total)
+	}
+}
+
+func TestFindConstellation(t *testing.T) {
+	table := readBoundaryTableExcerpt(t)
+	positions := []struct {
+		raDecimalHrs  float64
+		decDecimalDeg float64
+		epoch         float64
+		abbreviation  string
+	}{
+		{10, 87, constellation.B1875, "UMi"},
+		{4, 86, constellation.B1875, "Cep"},
+		{4, 84.9, constellation.B1875, "Oct"},
+		{2.530195, 89.264109, 2451545.0, "UMi"}, // Polaris at J2000.0
+	}
+	for _, position := range positions {
+		info, err := table.FindConstellation(position.raDecimalHrs, position.decDecimalDeg, position.epoch)
+		if err != nil || info.Abbreviation != position.abbreviation {
+			t.Fatalf("Error while Finding Constellation. Required: %s Got: %s (%v)", position.abbreviation, info.Abbreviation, err)
+		}
+	}
+
+	if _, err := (constellation.BoundaryTable{table[0]}).FindConstellation(4, 0, constellation.B1875); !errors.Is(err, constellation.ErrPositionNotCovered) {
+		t.Fatalf("Error while Finding Constellation. Required: %v Got: %v", constellation.ErrPositionNotCovered, err)
+	}
+}
+
+func TestCalculateConstellationArea(t *testing.T) {
+	const tolerance = 0.000001 // Define an acceptable error range
+
+	table := readBoundaryTableExcerpt(t)
+	band := func(hours, decDecimalDeg float64) float64 {
+		// Area in square degrees between a declination and +88 degrees over some hours of right ascension
+		return hours * 15 * (math.Sin(88*math.Pi/180) - math.Sin(decDecimalDeg*math.Pi/180)) * 180 / math.Pi
+	}
+	expected := (2 * math.Pi * (1 - math.Sin(88*math.Pi/180)) * math.Pow(180/math.Pi, 2)) + band(6.5, 86.5) + band(2, 86.1667) + band(3, 86)
+	area, err := table.CalculateConstellationArea("UMi")
+	if err != nil || math.Abs(area-expected) > tolerance {
+		t.Fatalf("Error while Calculating Constellation Area. Required: %f Got: %f (%v)", expected, area, err)
+	}
+}
+
+func TestCalculateBoundaryPolygons(t *testing.T) {
+	const tolerance = 0.000001 // Define an acceptable error range
+
+	// Cepheus is the rectangle from 0h to 8h between +85 and +88 degrees, with a point every degree along the
+	// parallels
+	table := readBoundaryTableExcerpt(t)
+	polygons, err := table.CalculateBoundaryPolygons("Cep", constellation.B1875)
+	if err != nil || len(polygons) != 1 || len(polygons[0]) != 242 {
+		t.Fatalf("Error while Calculating Boundary Polygons. Required: 1 polygon of %d points Got: %d (%v)", 242, len(polygons), err)
+	}
+	for _, point := range polygons[0] {
+		if point.RADecimalHrs > 24-tolerance {
+			point.RADecimalHrs -= 24
+		}
+		if point.RADecimalHrs < -tolerance || point.RADecimalHrs > 8+tolerance || point.DecDecimalDeg < 85-tolerance || point.DecDecimalDeg > 88+tolerance {
+			t.Fatalf("Error while Calculating Boundary Polygons. Required: a point inside 0h-8h +85-+88 Got: %f %f", point.RADecimalHrs, point.DecDecimalDeg)
+		}
+	}
+
+	// Ursa Minor surrounds the pole and Octans has the rest of the sky
+	for _, abbreviation := range []string{"UMi", "Oct"} {
+		polygons, err := table.CalculateBoundaryPolygons(abbreviation, 2451545.0)
+		if err != nil || len(polygons) != 1 {
+			t.Fatalf("Error while Calculating Boundary Polygons. Required: 1 polygon Got: %d (%v)", len(polygons), err)
+		}
+	}
+}
@@ -303,3 +303,44 @@ func TestCalculateGreenwichSiderealTimeUsingLocalSiderealTime(t *testing.T) {
 		}
 	}
 }
+
+// TestConvertJulianDateToGreenwichSiderealTime tests the mean sidereal time at Greenwich for any instant.
+func TestConvertJulianDateToGreenwichSiderealTime(t *testing.T) {
+	tests := []struct {
+		julianDate                     float64
+		expectedGSTHrs, expectedGSTMin int
+		expectedGSTSec                 float64
+	}{
+		{2446895.5, 13, 10, 46.37},    // 1987 April 10 at 0h UT
+		{2446896.30625, 8, 34, 57.09}, // 1987 April 10 at 19h21m UT
+	}
+
+	for _, test := range tests {
+		GSTHrs, GSTMin, GSTSec, _ := datetime.ConvertJulianDateToGreenwichSiderealTime(test.julianDate)
+		if GSTHrs != test.expectedGSTHrs || GSTMin != test.expectedGSTMin || math.Abs(GSTSec-test.expectedGSTSec) > tolerance {
+			t.Fatalf("Error while converting Julian Date to Greenwich Sidereal Time. Expected: %d:%d:%f    Got: %d:%d:%f",
+				test.expectedGSTHrs, test.expectedGSTMin, test.expectedGSTSec, GSTHrs, GSTMin, GSTSec)
+		}
+	}
+}
+
+// TestCalculateDeltaT tests the difference between dynamical time and universal time for several epochs.
+func TestCalculateDeltaT(t *testing.T) {
+	tests := []struct {
+		day            float64
+		month, year    int
+		expectedDeltaT float64
+	}{
+		{1, 1, 1900, -2.7},
+		{1, 1, 1950, 29.1},
+		{1, 1, 2000, 63.9},
+		{1, 1, 2020, 71.6},
+	}
+
+	for _, test := range tests {
+		deltaT := datetime.CalculateDeltaT(test.day, test.month, test.year)
+		if math.Abs(deltaT-test.expectedDeltaT) > 1.0 {
+			t.Fatalf("Error while calculating Delta T for %d. Expected: %f    Got: %f", test.year, test.expectedDeltaT, deltaT)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"go-astronomy/internal/coords"
+	datetime "go-astronomy/internal/dateTime"
+	"go-astronomy/internal/eclipse"
+	"math"
+	"testing"
+)
+
+func TestCalculateLunarEclipses(t *testing.T) {
+	lunarEclipses := eclipse.CalculateLunarEclipses(datetime.ConvertGreenwichDateToJulianDate(1, 1, 2022), datetime.ConvertGreenwichDateToJulianDate(1, 1, 2026))
+	expectedTypes := []string{eclipse.TotalEclipse, eclipse.TotalEclipse, eclipse.PenumbralEclipse, eclipse.PartialEclipse, eclipse.PenumbralEclipse, eclipse.PartialEclipse, eclipse.TotalEclipse, eclipse.TotalEclipse}
+
+	if len(lunarEclipses) != len(expectedTypes) {
+		t.Fatalf(`Error while Calculating Lunar Eclipses for 2022-2025. Required: %d eclipses  Got: %d`, len(expectedTypes), len(lunarEclipses))
+	}
+	for i, lunarEclipse := range lunarEclipses {
+		if lunarEclipse.EclipseType != expectedTypes[i] {
+			t.Fatalf(`Error while Calculating Lunar Eclipses for 2022-2025. Required: %s  Got: %s`, expectedTypes[i], lunarEclipse.EclipseType)
+		}
+	}
+}
+
+func TestCalculateLunarEclipse(t *testing.T) {
+	// Total lunar eclipse of 2022 November 8 (NASA: U1 09:09:12, U2 10:16:39, U3 11:41:35, U4 12:49:03 UT)
+	lunarEclipses := eclipse.CalculateLunarEclipses(datetime.ConvertGreenwichDateToJulianDate(7, 11, 2022), datetime.ConvertGreenwichDateToJulianDate(9, 11, 2022))
+	if len(lunarEclipses) != 1 {
+		t.Fatalf(`Error while Calculating Lunar Eclipse of 2022 November 8. Required: 1 eclipse  Got: %d`, len(lunarEclipses))
+	}
+	lunarEclipse := lunarEclipses[0]
+	const tolerance = 2.0 / 1440 // Define an acceptable error range
+
+	expectedContacts := []float64{
+		datetime.ConvertGreenwichDateToJulianDate(8+(9+(9.0/60))/24, 11, 2022),
+		datetime.ConvertGreenwichDateToJulianDate(8+(10+(16.6/60))/24, 11, 2022),
+		datetime.ConvertGreenwichDateToJulianDate(8+(11+(41.6/60))/24, 11, 2022),
+		datetime.ConvertGreenwichDateToJulianDate(8+(12+(49.0/60))/24, 11, 2022),
+	}
+	contacts := []float64{lunarEclipse.U1, lunarEclipse.U2, lunarEclipse.U3, lunarEclipse.U4}
+	for i := range contacts {
+		if math.Abs(contacts[i]-expectedContacts[i]) > tolerance {
+			t.Fatalf(`Error while Calculating Lunar Eclipse contacts. Required: %f  Got: %f`, expectedContacts[i], contacts[i])
+		}
+	}
+
+	if math.Abs(lunarEclipse.UmbralMagnitude-1.359) > 0.01 || math.Abs(lunarEclipse.PenumbralMagnitude-2.415) > 0.01 || math.Abs(lunarEclipse.Gamma-0.257) > 0.005 {
+		t.Fatalf(`Error while Calculating Lunar Eclipse magnitudes. Required: %f %f %f  Got: %f %f %f`, 1.359, 2.415, 0.257, lunarEclipse.UmbralMagnitude, lunarEclipse.PenumbralMagnitude, lunarEclipse.Gamma)
+	}
+}
+
+func TestCalculateLunarEclipseVisibility(t *testing.T) {
+	lunarEclipses := eclipse.CalculateLunarEclipses(datetime.ConvertGreenwichDateToJulianDate(7, 11, 2022), datetime.ConvertGreenwichDateToJulianDate(9, 11, 2022))
+
+	// The eclipse took place during daylight in London and at night in Honolulu
+	_, isVisible := eclipse.CalculateLunarEclipseVisibility(lunarEclipses[0], coords.Observer{GeoLatN: 51.5, GeoLong: -0.13})
+	if isVisible {
+		t.Fatalf(`Error while Calculating Lunar Eclipse Visibility for London. Required: %t  Got: %t`, false, isVisible)
+	}
+	moonAltitudes, isVisible := eclipse.CalculateLunarEclipseVisibility(lunarEclipses[0], coords.Observer{GeoLatN: 21.3, GeoLong: -157.86})
+	if !isVisible || moonAltitudes["Greatest"] < 30 {
+		t.Fatalf(`Error while Calculating Lunar Eclipse Visibility for Honolulu. Required: %t  Got: %t (altitude %f)`, true, isVisible, moonAltitudes["Greatest"])
+	}
+}
+
+func TestCalculateSolarEclipses(t *testing.T) {
+	solarEclipses := eclipse.CalculateSolarEclipses(datetime.ConvertGreenwichDateToJulianDate(1, 1, 2022), datetime.ConvertGreenwichDateToJulianDate(1, 1, 2026))
+	expectedTypes := []string{eclipse.PartialEclipse, eclipse.PartialEclipse, eclipse.HybridEclipse, eclipse.AnnularEclipse, eclipse.TotalEclipse, eclipse.AnnularEclipse, eclipse.PartialEclipse, eclipse.PartialEclipse}
+
+	if len(solarEclipses) != len(expectedTypes) {
+		t.Fatalf(`Error while Calculating Solar Eclipses for 2022-2025. Required: %d eclipses  Got: %d`, len(expectedTypes), len(solarEclipses))
+	}
+	for i, solarEclipse := range solarEclipses {
+		if solarEclipse.EclipseType != expectedTypes[i] {
+			t.Fatalf(`Error while Calculating Solar Eclipses for 2022-2025. Required: %s  Got: %s`, expectedTypes[i], solarEclipse.EclipseType)
+		}
+	}
+}
+
+func TestCalculateBesselianElements(t *testing.T) {
+	// Total solar eclipse of 2024 April 8, elements published by NASA for T0 = 18h TD
+	solarEclipses := eclipse.CalculateSolarEclipses(datetime.ConvertGreenwichDateToJulianDate(7, 4, 2024), datetime.ConvertGreenwichDateToJulianDate(9, 4, 2024))
+	if len(solarEclipses) != 1 {
+		t.Fatalf(`Error while Calculating Solar Eclipse of 2024 April 8. Required: 1 eclipse  Got: %d`, len(solarEclipses))
+	}
+	B := solarEclipses[0].BesselianElements
+	const tolerance = 0.005 // Define an acceptable error range
+
+	if math.Abs(B.T0-datetime.ConvertGreenwichDateToJulianDate(8.75, 4, 2024)) > 1e-6 || math.Abs(B.X[0]+0.318157) > tolerance || math.Abs(B.X[1]-0.511711) > tolerance ||
+		math.Abs(B.Y[0]-0.219747) > tolerance || math.Abs(B.Y[1]-0.270959) > tolerance || math.Abs(B.D[0]-7.5862) > 0.01 || math.Abs(B.Mu[0]-89.59122) > 0.01 ||
+		math.Abs(B.L1[0]-0.535813) > tolerance || math.Abs(B.L2[0]+0.010274) > tolerance {
+		t.Fatalf(`Error while Calculating Besselian Elements. Required: x %f y %f d %f mu %f l1 %f l2 %f  Got: x %f y %f d %f mu %f l1 %f l2 %f`, -0.318157, 0.219747, 7.5862, 89.59122, 0.535813, -0.010274, B.X[0], B.Y[0], B.D[0], B.Mu[0], B.L1[0], B.L2[0])
+	}
+
+	if math.Abs(solarEclipses[0].Gamma-0.3431) > 0.005 || math.Abs(solarEclipses[0].Magnitude-1.0566) > 0.005 {
+		t.Fatalf(`Error while Calculating Solar Eclipse of 2024 April 8. Required: gamma %f magnitude %f  Got: gamma %f magnitude %f`, 0.3431, 1.0566, solarEclipses[0].Gamma, solarEclipses[0].Magnitude)
+	}
+}
+
+func TestCalculateLocalSolarEclipseCircumstances(t *testing.T) {
+	solarEclipses := eclipse.CalculateSolarEclipses(datetime.ConvertGreenwichDateToJulianDate(7, 4, 2024), datetime.ConvertGreenwichDateToJulianDate(9, 4, 2024))
+	const tolerance = 2.0 / 1440 // Define an acceptable error range
+
+	// Dallas, Texas was inside the path of totality (NASA: C1 17:23, C2 18:40:40, C3 18:44:30, C4 20:02 UT)
+	localCircumstances, isVisible := eclipse.CalculateLocalSolarEclipseCircumstances(solarEclipses[0], coords.Observer{GeoLatN: 32.78, GeoLong: -96.80, HeightFromSeaLevel: 140})
+	if !isVisible || localCircumstances.EclipseType != eclipse.TotalEclipse {
+		t.Fatalf(`Error while Calculating Local Solar Eclipse Circumstances for Dallas. Required: %s  Got: %s`, eclipse.TotalEclipse, localCircumstances.EclipseType)
+	}
+	expectedContacts := []float64{
+		datetime.ConvertGreenwichDateToJulianDate(8+(17+(23.0/60))/24, 4, 2024),
+		datetime.ConvertGreenwichDateToJulianDate(8+(18+(40.7/60))/24, 4, 2024),
+		datetime.ConvertGreenwichDateToJulianDate(8+(18+(44.5/60))/24, 4, 2024),
+		datetime.ConvertGreenwichDateToJulianDate(8+(20+(2.0/60))/24, 4, 2024),
+	}
+	contacts := []float64{localCircumstances.C1, localCircumstances.C2, localCircumstances.C3, localCircumstances.C4}
+	for i := range contacts {
+		if math.Abs(contacts[i]-expectedContacts[i]) > tolerance {
+			t.Fatalf(`Error while Calculating Local Solar Eclipse contacts for Dallas. Required: %f  Got: %f`, expectedContacts[i], contacts[i])
+		}
+	}
+	if localCircumstances.Obscuration != 1 || localCircumstances.SunAltitudes["Maximum"] < 60 {
+		t.Fatalf(`Error while Calculating Local Solar Eclipse obscuration for Dallas. Required: %f  Got: %f`, 1.0, localCircumstances.Obscuration)
+	}
+
+	// New York City saw a deep partial eclipse and London none at all
+	localCircumstances, isVisible = eclipse.CalculateLocalSolarEclipseCircumstances(solarEclipses[0], coords.Observer{GeoLatN: 40.71, GeoLong: -74.0, HeightFromSeaLevel: 10})
+	if !isVisible || localCircumstances.EclipseType != eclipse.PartialEclipse || math.Abs(localCircumstances.Magnitude-0.90) > 0.02 {
+		t.Fatalf(`Error while Calculating Local Solar Eclipse magnitude for New York. Required: %f  Got: %f`, 0.90, localCircumstances.Magnitude)
+	}
+	_, isVisible = eclipse.CalculateLocalSolarEclipseCircumstances(solarEclipses[0], coords.Observer{GeoLatN: 51.5, GeoLong: -0.13, HeightFromSeaLevel: 10})
+	if isVisible {
+		t.Fatalf(`Error while Calculating Local Solar Eclipse Circumstances for London. Required: %t  Got: %t`, false, isVisible)
+	}
+}
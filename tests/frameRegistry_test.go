@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"errors"
+	"go-astronomy/internal/coords"
+	"go-astronomy/internal/sun"
+	"math"
+	"testing"
+)
+
+func TestConvertGalacticToSupergalactic(t *testing.T) {
+	const tolerance = 1e-9 // Define an acceptable error range
+
+	// The zero point of supergalactic longitude and the supergalactic pole (de Vaucouleurs 1976)
+	if sgl, sgb := coords.ConvertGalacticDecimalDegToSupergalactic(137.37, 0); math.Abs(math.Mod(sgl+180, 360)-180) > tolerance || math.Abs(sgb) > tolerance {
+		t.Fatalf("Error while Converting Galactic To Supergalactic. Required: %f %f Got: %f %f", 0.0, 0.0, sgl, sgb)
+	}
+	if _, sgb := coords.ConvertGalacticDecimalDegToSupergalactic(47.37, 6.32); math.Abs(sgb-90) > tolerance {
+		t.Fatalf("Error while Converting Galactic To Supergalactic. Required: %f Got: %f", 90.0, sgb)
+	}
+	if l, b := coords.ConvertSupergalacticDecimalDegToGalactic(coords.ConvertGalacticDecimalDegToSupergalactic(283.8, 74.5)); math.Abs(l-283.8) > tolerance || math.Abs(b-74.5) > tolerance {
+		t.Fatalf("Error while Converting Supergalactic To Galactic. Required: %f %f Got: %f %f", 283.8, 74.5, l, b)
+	}
+}
+
+func TestConvertSphericalPositionToFrame(t *testing.T) {
+	const tolerance = 1e-4 // Define an acceptable error range
+
+	// The centre of the Galaxy in the ICRS (Hipparcos catalogue, volume 1)
+	centre := coords.SphericalPosition{Longitude: 266.40499, Latitude: -28.93617, Frame: coords.FrameICRS}
+	galactic, err := centre.ConvertToFrame(coords.FrameGalactic, 2451545.0)
+	if err != nil || math.Abs(math.Mod(galactic.Longitude+180, 360)-180) > tolerance || math.Abs(galactic.Latitude) > tolerance || galactic.DistanceAU != 0 {
+		t.Fatalf("Error while Converting ICRS To Galactic. Required: %f %f Got: %f %f (%v)", 0.0, 0.0, galactic.Longitude, galactic.Latitude, err)
+	}
+
+	// Virgo cluster through galactic to supergalactic and back to the ICRS
+	virgo := coords.SphericalPosition{Longitude: 187.7, Latitude: 12.4, Frame: coords.FrameICRS}
+	supergalactic, _ := virgo.ConvertToFrame(coords.FrameSupergalactic, 2451545.0)
+	galactic, _ = virgo.ConvertToFrame(coords.FrameGalactic, 2451545.0)
+	sgl, sgb := coords.ConvertGalacticDecimalDegToSupergalactic(galactic.Longitude, galactic.Latitude)
+	back, _ := supergalactic.ConvertToFrame(coords.FrameICRS, 2451545.0)
+	if math.Abs(supergalactic.Longitude-sgl) > 1e-9 || math.Abs(supergalactic.Latitude-sgb) > 1e-9 || math.Abs(back.Longitude-virgo.Longitude) > 1e-9 || math.Abs(back.Latitude-virgo.Latitude) > 1e-9 {
+		t.Fatalf("Error while Converting ICRS To Supergalactic. Required: %f %f Got: %f %f", sgl, sgb, supergalactic.Longitude, supergalactic.Latitude)
+	}
+
+	// The north pole of the J2000.0 ecliptic, and the ecliptic of date agreeing with it at J2000.0
+	pole := coords.SphericalPosition{Longitude: 270.0, Latitude: 66.560708, Frame: coords.FrameICRS}
+	ecliptic, _ := pole.ConvertToFrame(coords.FrameEclipticJ2000, 2460676.5)
+	ofDate, _ := virgo.ConvertToFrame(coords.FrameEclipticOfDate, 2451545.0)
+	j2000, _ := virgo.ConvertToFrame(coords.FrameEclipticJ2000, 2451545.0)
+	if math.Abs(ecliptic.Latitude-90) > tolerance || math.Abs(ofDate.Longitude-j2000.Longitude) > 1e-9 || math.Abs(ofDate.Latitude-j2000.Latitude) > 1e-9 {
+		t.Fatalf("Error while Converting ICRS To Ecliptic. Required: %f Got: %f", 90.0, ecliptic.Latitude)
+	}
+
+	if _, err := virgo.ConvertToFrame("Unknown", 2451545.0); !errors.Is(err, coords.ErrUnknownFrame) {
+		t.Fatalf("Error while Converting Frames. Required: %v Got: %v", coords.ErrUnknownFrame, err)
+	}
+}
+
+func TestConvertToHeliocentricFrame(t *testing.T) {
+	const tolerance = 1e-9 // Define an acceptable error range
+	const julianEphemerisDate = 2448908.5
+
+	// A point twice as far as the Sun in its direction lies one solar distance beyond the Sun
+	trueLongitude, _, distanceAU := sun.CalculateEclipticCoordinatesOfSun(julianEphemerisDate)
+	beyondSun := coords.SphericalPosition{Longitude: trueLongitude, Latitude: 0, DistanceAU: 2 * distanceAU, Frame: coords.FrameEclipticOfDate}
+	heliocentric, err := beyondSun.ConvertToFrame(sun.FrameHeliocentricEclipticOfDate, julianEphemerisDate)
+	if err != nil || math.Abs(heliocentric.Longitude-trueLongitude) > tolerance || math.Abs(heliocentric.Latitude) > tolerance || math.Abs(heliocentric.DistanceAU-distanceAU) > tolerance {
+		t.Fatalf("Error while Converting To Heliocentric. Required: %f %f %f Got: %f %f %f (%v)", trueLongitude, 0.0, distanceAU, heliocentric.Longitude, heliocentric.Latitude, heliocentric.DistanceAU, err)
+	}
+
+	// The Earth seen from the Sun is opposite the Sun seen from the Earth, and directions of stars do not move
+	earth, _ := coords.SphericalPosition{Longitude: 0, Latitude: 0, DistanceAU: 1e-12, Frame: coords.FrameEclipticJ2000}.ConvertToFrame(sun.FrameHeliocentricEclipticJ2000, julianEphemerisDate)
+	sunJ2000, _ := coords.SphericalPosition{Longitude: trueLongitude, Latitude: 0, DistanceAU: distanceAU, Frame: coords.FrameEclipticOfDate}.ConvertToFrame(coords.FrameEclipticJ2000, julianEphemerisDate)
+	star, _ := coords.SphericalPosition{Longitude: 120, Latitude: 30, Frame: coords.FrameEclipticJ2000}.ConvertToFrame(sun.FrameHeliocentricEclipticJ2000, julianEphemerisDate)
+	if math.Abs(math.Mod(earth.Longitude-sunJ2000.Longitude+360, 360)-180) > 1e-6 || math.Abs(earth.DistanceAU-distanceAU) > 1e-9 || math.Abs(star.Longitude-120) > tolerance || math.Abs(star.Latitude-30) > tolerance {
+		t.Fatalf("Error while Converting To Heliocentric. Required: %f %f Got: %f %f", math.Mod(sunJ2000.Longitude+180, 360), distanceAU, earth.Longitude, earth.DistanceAU)
+	}
+}
@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"errors"
+	"go-astronomy/internal/coords"
+	datetime "go-astronomy/internal/dateTime"
+	"go-astronomy/internal/macros"
+	"math"
+	"testing"
+)
+
+func TestConvertEquatorialToHorizon(t *testing.T) {
+	const tolerance = 0.001 // Define an acceptable error range
+
+	// Venus from the US Naval Observatory on 1987 April 10 at 19h21m UT (Meeus example 13.b)
+	observer := coords.Observer{GeoLatN: macros.ConvertDegMinSecToDecimalDeg(38, 55, 17), GeoLong: -macros.ConvertDegMinSecToDecimalDeg(77, 3, 56)}
+	instant := coords.Instant{JulianDate: datetime.ConvertGreenwichDateToJulianDate(10, 4, 1987) + (datetime.ConvertHrsMinSecToDecimalHrs(19, 21, 0, false, false) / 24)}
+	raDecimalHrs := datetime.ConvertHrsMinSecToDecimalHrs(23, 9, 16.641, false, false)
+	decDecimalDeg := -macros.ConvertDegMinSecToDecimalDeg(6, 43, 11.61)
+
+	fromSouth := coords.ConvertEquatorialToHorizon(raDecimalHrs, decDecimalDeg, observer, instant, coords.AzimuthFromSouth)
+	if math.Abs(fromSouth.HourAngle-64.352133) > tolerance || math.Abs(fromSouth.Azimuth-68.0337) > tolerance || math.Abs(fromSouth.Altitude-15.1249) > tolerance {
+		t.Fatalf("Error while Converting Equatorial To Horizon. Required: %f %f %f Got: %f %f %f", 64.352133, 68.0337, 15.1249, fromSouth.HourAngle, fromSouth.Azimuth, fromSouth.Altitude)
+	}
+	fromNorth := coords.ConvertEquatorialToHorizon(raDecimalHrs, decDecimalDeg, observer, instant, coords.AzimuthFromNorth)
+	if math.Abs(fromNorth.Azimuth-248.0337) > tolerance || fromNorth.Altitude != fromSouth.Altitude || fromNorth.ParallacticAngle != fromSouth.ParallacticAngle {
+		t.Fatalf("Error while Converting Equatorial To Horizon. Required: %f Got: %f", 248.0337, fromNorth.Azimuth)
+	}
+
+	ra, dec := coords.ConvertHorizonToEquatorial(fromSouth.Altitude, fromSouth.Azimuth, coords.AzimuthFromSouth, observer, instant)
+	if math.Abs(ra-raDecimalHrs)*15 > 1e-9 || math.Abs(dec-decDecimalDeg) > 1e-9 {
+		t.Fatalf("Error while Converting Horizon To Equatorial. Required: %f %f Got: %f %f", raDecimalHrs, decDecimalDeg, ra, dec)
+	}
+}
+
+func TestCalculateParallacticAngle(t *testing.T) {
+	const tolerance = 1e-9 // Define an acceptable error range
+
+	// Zero on the meridian south of the zenith, 180 north of it, and of opposite sign either side of the meridian
+	if q := coords.CalculateParallacticAngle(0, 10, 50); math.Abs(q) > tolerance {
+		t.Fatalf("Error while Calculating Parallactic Angle. Required: %f Got: %f", 0.0, q)
+	}
+	if q := coords.CalculateParallacticAngle(0, 70, 50); math.Abs(math.Abs(q)-180) > tolerance {
+		t.Fatalf("Error while Calculating Parallactic Angle. Required: %f Got: %f", 180.0, q)
+	}
+	east, west := coords.CalculateParallacticAngle(-30, 20, 50), coords.CalculateParallacticAngle(30, 20, 50)
+	if west <= 0 || math.Abs(east+west) > tolerance {
+		t.Fatalf("Error while Calculating Parallactic Angle. Required: %f Got: %f", -west, east)
+	}
+
+	// At the zenith the angle is undefined and set to zero, and the hour angle is kept between -180 and 180
+	horizon := coords.ConvertHourAngleDecimalDegToHorizonCoordinates(0, 50, 50, coords.AzimuthFromNorth)
+	west = coords.ConvertHourAngleDecimalDegToHorizonCoordinates(330, 20, 50, coords.AzimuthFromNorth).ParallacticAngle
+	if horizon.ParallacticAngle != 0 || math.Abs(horizon.Altitude-90) > tolerance || math.Abs(west-east) > tolerance {
+		t.Fatalf("Error while Calculating Parallactic Angle. Required: %f %f Got: %f %f", 0.0, east, horizon.ParallacticAngle, west)
+	}
+}
+
+func TestCalculateTrackingRates(t *testing.T) {
+	const tolerance = 1e-8 // Define an acceptable error range, in degrees per second
+
+	// Rates against the change of the horizon coordinates over a minute around the instant
+	observer := coords.Observer{GeoLatN: 19.8207, GeoLong: -155.4681}
+	instant := coords.Instant{JulianDate: 2460676.75}
+	rates, err := coords.CalculateTrackingRates(5.5, 25, observer, instant)
+	before := coords.ConvertEquatorialToHorizon(5.5, 25, observer, coords.Instant{JulianDate: instant.JulianDate - (30.0 / 86400)}, coords.AzimuthFromNorth)
+	after := coords.ConvertEquatorialToHorizon(5.5, 25, observer, coords.Instant{JulianDate: instant.JulianDate + (30.0 / 86400)}, coords.AzimuthFromNorth)
+	altitudeRate, azimuthRate := (after.Altitude-before.Altitude)/60, math.Remainder(after.Azimuth-before.Azimuth, 360)/60
+	parallacticAngleRate := math.Remainder(after.ParallacticAngle-before.ParallacticAngle, 360) / 60
+	if err != nil || math.Abs(rates.AltitudeRate-altitudeRate) > tolerance || math.Abs(rates.AzimuthRate-azimuthRate) > tolerance || math.Abs(rates.ParallacticAngleRate-parallacticAngleRate) > tolerance {
+		t.Fatalf("Error while Calculating Tracking Rates. Required: %.9f %.9f %.9f Got: %.9f %.9f %.9f (%v)", altitudeRate, azimuthRate, parallacticAngleRate, rates.AltitudeRate, rates.AzimuthRate, rates.ParallacticAngleRate, err)
+	}
+
+	// A body crossing the zenith has no azimuth or field rotation rate there
+	if rates, err := coords.CalculateHorizonRates(0, 40, 40); !errors.Is(err, coords.ErrAtZenith) || rates.AzimuthRate != 0 || math.Abs(rates.AltitudeRate) > tolerance {
+		t.Fatalf("Error while Calculating Tracking Rates. Required: %v Got: %v", coords.ErrAtZenith, err)
+	}
+	if _, err := coords.CalculateHorizonRates(0.01, 40, 40); err != nil {
+		t.Fatalf("Error while Calculating Tracking Rates. Required: %v Got: %v", nil, err)
+	}
+}
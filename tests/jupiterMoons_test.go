@@ -0,0 +1,80 @@
+package tests
+
+import (
+	datetime "go-astronomy/internal/dateTime"
+	jupitermoons "go-astronomy/internal/jupiterMoons"
+	"math"
+	"testing"
+)
+
+func TestCalculateApproximatePositionsOfSatellites(t *testing.T) {
+	// Meeus example 44.a
+	expected := [4][2]float64{{-3.44, 0.21}, {7.44, 0.25}, {1.24, 0.65}, {7.08, 1.10}}
+	const tolerance = 0.01 // Define an acceptable error range
+
+	positions := jupitermoons.CalculateApproximatePositionsOfSatellites(2448972.50068)
+	for i, position := range positions {
+		if math.Abs(position.X-expected[i][0]) > tolerance || math.Abs(position.Y-expected[i][1]) > tolerance {
+			t.Fatalf("Error while Calculating Approximate Position of %s. Required: %f %f Got: %f %f", jupitermoons.SatelliteNames[i], expected[i][0], expected[i][1], position.X, position.Y)
+		}
+	}
+}
+
+func TestCalculatePositionsOfSatellites(t *testing.T) {
+	// Meeus example 44.a, high accuracy method
+	expected := [4][2]float64{{-3.4503, 0.2137}, {7.4418, 0.2752}, {1.2011, 0.5900}, {7.0720, 1.0291}}
+	const tolerance = 0.001 // Define an acceptable error range
+
+	positions := jupitermoons.CalculatePositionsOfSatellites(2448972.50068)
+	for i, position := range positions {
+		if math.Abs(position.X-expected[i][0]) > tolerance || math.Abs(position.Y-expected[i][1]) > tolerance {
+			t.Fatalf("Error while Calculating Position of %s. Required: %f %f Got: %f %f", jupitermoons.SatelliteNames[i], expected[i][0], expected[i][1], position.X, position.Y)
+		}
+	}
+
+	// Ganymede was in conjunction with Jupiter at 7h28m TD on 1988 November 23 (Meeus exercise, chapter 44)
+	// The instant is given to the minute, in which Ganymede moves about 0.01 radius, and the latitude only roughly
+	const conjunctionTolerance = 0.01
+	const latitudeTolerance = 0.1
+	ganymede := jupitermoons.CalculatePositionsOfSatellites(datetime.ConvertGreenwichDateToJulianDate(23+((7+(28.0/60))/24), 11, 1988))[2]
+	if math.Abs(ganymede.X) > conjunctionTolerance || math.Abs(ganymede.Y+0.84) > latitudeTolerance {
+		t.Fatalf("Error while Calculating Position of Ganymede. Required: %f %f Got: %f %f", 0.0, -0.84, ganymede.X, ganymede.Y)
+	}
+}
+
+func TestCalculateSatelliteEvents(t *testing.T) {
+	// Io goes round Jupiter in 1.77 days, so three days hold two of each of its phenomena lasting a little over
+	// two hours, and every phenomenon begins on the limb of Jupiter.
+	const tolerance = 0.001 // Define an acceptable error range
+
+	events := jupitermoons.CalculateSatelliteEvents(2448972.5, 2448975.5)
+	ioEvents := map[string]int{}
+	for _, event := range events {
+		if event.Start < 2448972.5 || event.Start > 2448975.5 || event.End <= event.Start {
+			t.Fatalf("Error while Calculating Satellite Events. Required: Start within the range and before End Got: %f %f", event.Start, event.End)
+		}
+		if event.Satellite == "Io" {
+			ioEvents[event.EventType]++
+			if duration := (event.End - event.Start) * 24; duration < 2 || duration > 2.5 {
+				t.Fatalf("Error while Calculating Duration of %s of Io. Required: 2 to 2.5 hours Got: %f", event.EventType, duration)
+			}
+		}
+
+		position := jupitermoons.CalculatePositionsOfSatellites(datetime.ConvertUniversalTimeToEphemerisTime(event.Start))
+		if event.EventType == jupitermoons.ShadowTransit || event.EventType == jupitermoons.Eclipse {
+			position = jupitermoons.CalculatePositionsOfShadows(datetime.ConvertUniversalTimeToEphemerisTime(event.Start))
+		}
+		for i, name := range jupitermoons.SatelliteNames {
+			if name == event.Satellite {
+				if limb := math.Pow(position[i].X, 2) + math.Pow(position[i].Y*1.071374, 2); math.Abs(limb-1) > tolerance {
+					t.Fatalf("Error while Calculating %s of %s. Required: %f Got: %f", event.EventType, name, 1.0, limb)
+				}
+			}
+		}
+	}
+	for _, eventType := range []string{jupitermoons.Transit, jupitermoons.ShadowTransit, jupitermoons.Occultation, jupitermoons.Eclipse} {
+		if ioEvents[eventType] != 2 {
+			t.Fatalf("Error while Calculating %s of Io. Required: %d Got: %d", eventType, 2, ioEvents[eventType])
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package tests
+
+import (
+	"go-astronomy/internal/moon"
+	"math"
+	"testing"
+)
+
+func TestCalculateEclipticCoordinatesOfMoon(t *testing.T) {
+	// 1992 April 12 at 0h TD
+	lambda, beta, distanceKm := moon.CalculateEclipticCoordinatesOfMoon(2448724.5)
+	const tolerance = 0.00001 // Define an acceptable error range
+
+	if math.Abs(lambda-133.162655) > tolerance || math.Abs(beta+3.229126) > tolerance || math.Abs(distanceKm-368409.7) > 0.1 {
+		t.Fatalf(`Error while Calculating Ecliptic Coordinates Of Moon. Required: %f %f %f  Got: %f %f %f`, 133.162655, -3.229126, 368409.7, lambda, beta, distanceKm)
+	}
+
+	parallax := moon.CalculateHorizontalParallaxOfMoon(distanceKm)
+	if math.Abs(parallax-0.991990) > tolerance {
+		t.Fatalf(`Error while Calculating Horizontal Parallax Of Moon. Required: %f  Got: %f`, 0.991990, parallax)
+	}
+}
+
+func TestCalculateApparentPositionOfMoon(t *testing.T) {
+	raHrs, raMin, raSec, decDeg, decMin, decSec, _, _, _ := moon.CalculateApparentPositionOfMoon(2448724.5)
+	const tolerance = 0.5 // Define an acceptable error range
+
+	if raHrs != 8 || raMin != 58 || math.Abs(raSec-45.2) > tolerance || decDeg != 13 || decMin != 46 || math.Abs(decSec-6.0) > tolerance {
+		t.Fatalf(`Error while Calculating Apparent Position Of Moon. Required: %d %d %f  %d %d %f  Got: %d %d %f  %d %d %f`, 8, 58, 45.2, 13, 46, 6.0, raHrs, raMin, raSec, decDeg, decMin, decSec)
+	}
+}
@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"go-astronomy/internal/coords"
+	"math"
+	"testing"
+)
+
+func TestCalculateNutationIAU1980(t *testing.T) {
+	// 1987 April 10 0h TD (Meeus example 22.a)
+	const tolerance = 0.001 // Define an acceptable error range
+
+	nutationInLongitude, nutationInObliquity := coords.CalculateNutationIAU1980(2446895.5)
+	if math.Abs(nutationInLongitude*3600-(-3.788)) > tolerance || math.Abs(nutationInObliquity*3600-9.443) > tolerance {
+		t.Fatalf("Error while Calculating Nutation IAU 1980. Required: %f %f Got: %f %f", -3.788, 9.443, nutationInLongitude*3600, nutationInObliquity*3600)
+	}
+
+	meanObliquity := coords.CalculateMeanObliquityIAU1980(2446895.5)
+	if math.Abs(meanObliquity*3600-((23*3600)+(26*60)+27.407)) > tolerance {
+		t.Fatalf("Error while Calculating Mean Obliquity IAU 1980. Required: %f Got: %f", (23*3600)+(26*60)+27.407, meanObliquity*3600)
+	}
+}
+
+func TestCalculateNutationIAU2000B(t *testing.T) {
+	// SOFA iauNut00b for MJD 53736.0 TT, in radians
+	const tolerance = 1e-13 // Define an acceptable error range
+
+	nutationInLongitude, nutationInObliquity := coords.CalculateNutationIAU2000B(2400000.5 + 53736.0)
+	nutationInLongitude, nutationInObliquity = nutationInLongitude*math.Pi/180, nutationInObliquity*math.Pi/180
+	if math.Abs(nutationInLongitude-(-0.9632552291148362783e-5)) > tolerance || math.Abs(nutationInObliquity-0.4063197106621159367e-4) > tolerance {
+		t.Fatalf("Error while Calculating Nutation IAU 2000B. Required: %.16f %.16f Got: %.16f %.16f", -0.9632552291148362783e-5, 0.4063197106621159367e-4, nutationInLongitude, nutationInObliquity)
+	}
+
+	// Both models agree to a few milliarcseconds
+	longitude1980, obliquity1980 := coords.CalculateNutationIAU1980(2446895.5)
+	longitude2000, obliquity2000 := coords.CalculateNutationIAU2000B(2446895.5)
+	if math.Abs(longitude2000-longitude1980)*3600 > 0.05 || math.Abs(obliquity2000-obliquity1980)*3600 > 0.05 {
+		t.Fatalf("Error while Calculating Nutation IAU 2000B. Required: %f %f Got: %f %f", longitude1980*3600, obliquity1980*3600, longitude2000*3600, obliquity2000*3600)
+	}
+}
+
+func TestCalculateTrueObliquity(t *testing.T) {
+	// 1987 April 10 0h TD (Meeus example 22.a), the IAU 2006 mean obliquity is 0.04" below the IAU 1980 one
+	const tolerance = 0.1 // Define an acceptable error range
+
+	trueObliquity := coords.CalculateTrueObliquity(2446895.5)
+	if math.Abs(trueObliquity*3600-((23*3600)+(26*60)+36.850)) > tolerance {
+		t.Fatalf("Error while Calculating True Obliquity. Required: %f Got: %f", (23*3600)+(26*60)+36.850, trueObliquity*3600)
+	}
+}
+
+func TestCalculateNutationMatrix(t *testing.T) {
+	// SOFA iauNum00a for MJD 53736.0 TT, which the IAU 2000B model follows to about 1 mas
+	matrix := coords.CalculateNutationMatrix(2400000.5 + 53736.0)
+	compareMatrices(t, "Nutation Matrix", matrix, [3][3]float64{
+		{0.9999999999536227949, 0.8836238544090873336e-5, 0.3830835237722400669e-5},
+		{-0.8836082880798569274e-5, 0.9999999991354655028, -0.4063240865362499850e-4},
+		{-0.3831194272065995866e-5, 0.4063237480216291775e-4, 0.9999999991671660338},
+	}, 1e-8)
+
+	// To first order the matrix holds the nutation in longitude projected on the equator and the nutation in
+	// obliquity
+	const tolerance = 1e-9 // Define an acceptable error range
+	_, _, _, meanObliquity := coords.CalculateFukushimaWilliamsAngles(2400000.5 + 53736.0)
+	nutationInLongitude, nutationInObliquity := coords.CalculateNutationIAU2000B(2400000.5 + 53736.0)
+	nutationInLongitude, nutationInObliquity, meanObliquity = nutationInLongitude*math.Pi/180, nutationInObliquity*math.Pi/180, meanObliquity*math.Pi/180
+	if math.Abs(matrix[0][1]+(nutationInLongitude*math.Cos(meanObliquity))) > tolerance || math.Abs(matrix[1][2]+nutationInObliquity) > tolerance {
+		t.Fatalf("Error while Calculating Nutation Matrix. Required: %.16f %.16f Got: %.16f %.16f", -nutationInLongitude*math.Cos(meanObliquity), -nutationInObliquity, matrix[0][1], matrix[1][2])
+	}
+}
+
+func TestCalculateEquationOfEquinoxes(t *testing.T) {
+	// 1987 April 10 0h UT (Meeus example 12.a), -0.2317 seconds of time
+	const tolerance = 0.001 // Define an acceptable error range
+
+	equationOfEquinoxes := coords.CalculateEquationOfEquinoxes(2446895.5)
+	if math.Abs(equationOfEquinoxes*240-(-0.2317)) > tolerance {
+		t.Fatalf("Error while Calculating Equation Of Equinoxes. Required: %f Got: %f", -0.2317, equationOfEquinoxes*240)
+	}
+
+	apparentSiderealTime := coords.CalculateGreenwichApparentSiderealTime(2446895.5)
+	required := (13 * 15) + (10 * 15.0 / 60) + (46.1351 * 15 / 3600)
+	if math.Abs(apparentSiderealTime-required)*240 > 0.01 {
+		t.Fatalf("Error while Calculating Greenwich Apparent Sidereal Time. Required: %f Got: %f", required, apparentSiderealTime)
+	}
+}
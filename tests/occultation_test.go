@@ -0,0 +1,60 @@
+package tests
+
+import (
+	"go-astronomy/internal/coords"
+	datetime "go-astronomy/internal/dateTime"
+	"go-astronomy/internal/occultation"
+	starcatalog "go-astronomy/internal/starCatalog"
+	"math"
+	"testing"
+)
+
+func TestCalculateApparentPositionOfStar(t *testing.T) {
+	// Theta Persei on 2028 November 13.19 TD (Meeus example 23.a), whose proper motion of +0.03425s and -0.0895"
+	// a year is given in milliarcseconds of great circle
+	star := starcatalog.Star{Name: "Theta Persei", RADecimalHrs: 2 + (44.0 / 60) + (11.986 / 3600), DecDecimalDeg: 49 + (13.0 / 60) + (42.48 / 3600), Epoch: 2000.0, ProperMotionRA: 335.502, ProperMotionDec: -89.5}
+	raDecimalHrs, decDecimalDeg := occultation.CalculateApparentPositionOfStar(star, 2462088.69)
+	const tolerance = 0.5 / 3600 // Define an acceptable error range
+
+	if math.Abs(raDecimalHrs-(2+(46.0/60)+(14.390/3600))) > 0.1/3600 || math.Abs(decDecimalDeg-(49+(21.0/60)+(7.45/3600))) > tolerance {
+		t.Fatalf(`Error while Calculating Apparent Position Of Star. Required: %f %f  Got: %f %f`, 2+(46.0/60)+(14.390/3600), 49+(21.0/60)+(7.45/3600), raDecimalHrs, decDecimalDeg)
+	}
+}
+
+func TestCalculatePlanetOccultations(t *testing.T) {
+	// Occultation of Mars by the full Moon seen from London on 2022 December 8
+	occultations := occultation.CalculatePlanetOccultations("Mars", datetime.ConvertGreenwichDateToJulianDate(7, 12, 2022), datetime.ConvertGreenwichDateToJulianDate(9, 12, 2022), coords.Observer{GeoLatN: 51.5, GeoLong: -0.13, HeightFromSeaLevel: 10})
+	const tolerance = 3.0 / 1440 // Define an acceptable error range
+
+	if len(occultations) != 1 {
+		t.Fatalf(`Error while Calculating Planet Occultations of Mars. Required: 1 occultation  Got: %d`, len(occultations))
+	}
+	disappearance, reappearance := occultations[0].Disappearance, occultations[0].Reappearance
+	if math.Abs(disappearance.Time-datetime.ConvertGreenwichDateToJulianDate(8+(4+(56.0/60))/24, 12, 2022)) > tolerance || math.Abs(reappearance.Time-datetime.ConvertGreenwichDateToJulianDate(8+(5+(56.0/60))/24, 12, 2022)) > tolerance {
+		t.Fatalf(`Error while Calculating Planet Occultations of Mars. Required: %f %f  Got: %f %f`, datetime.ConvertGreenwichDateToJulianDate(8+(4+(56.0/60))/24, 12, 2022), datetime.ConvertGreenwichDateToJulianDate(8+(5+(56.0/60))/24, 12, 2022), disappearance.Time, reappearance.Time)
+	}
+	if disappearance.PositionAngle > 180 || reappearance.PositionAngle < 180 || disappearance.MoonAltitude < 0 || reappearance.MoonAltitude < 0 {
+		t.Fatalf(`Error while Calculating Planet Occultations of Mars. Required: PA < 180 and PA > 180  Got: %f %f`, disappearance.PositionAngle, reappearance.PositionAngle)
+	}
+}
+
+func TestCalculateStarGrazeLimits(t *testing.T) {
+	// Observers just inside each limit see Spica occulted on 2024 October 3, observers just outside do not
+	spica := starcatalog.Star{Name: "Spica", RADecimalHrs: 13 + (25.0 / 60) + (11.579 / 3600), DecDecimalDeg: -(11 + (9.0 / 60) + (40.75 / 3600)), Epoch: 2000.0}
+	start := datetime.ConvertGreenwichDateToJulianDate(3.9, 10, 2024)
+	northernLimit, southernLimit := occultation.CalculateStarGrazeLimits(spica, start, start+0.1)
+
+	if len(northernLimit) == 0 || len(southernLimit) == 0 {
+		t.Fatalf(`Error while Calculating Star Graze Limits of Spica. Required: limit lines  Got: %d %d points`, len(northernLimit), len(southernLimit))
+	}
+	for _, limit := range []struct {
+		point     occultation.GrazeLimitPoint
+		direction float64
+	}{{northernLimit[len(northernLimit)/2], 1}, {southernLimit[len(southernLimit)/2], -1}} {
+		inside := occultation.CalculateStarOccultations([]starcatalog.Star{spica}, start-0.2, start+0.3, coords.Observer{GeoLatN: limit.point.GeoLatN - (limit.direction * 0.02), GeoLong: limit.point.GeoLong})
+		outside := occultation.CalculateStarOccultations([]starcatalog.Star{spica}, start-0.2, start+0.3, coords.Observer{GeoLatN: limit.point.GeoLatN + (limit.direction * 0.02), GeoLong: limit.point.GeoLong})
+		if len(inside) != 1 || len(outside) != 0 {
+			t.Fatalf(`Error while Calculating Star Graze Limits of Spica at %f %f. Required: 1 and 0 occultations  Got: %d and %d`, limit.point.GeoLatN, limit.point.GeoLong, len(inside), len(outside))
+		}
+	}
+}
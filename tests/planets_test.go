@@ -42,3 +42,38 @@ func TestCalculatePerturbationsInPlanetsOrbit(t *testing.T) {
 		t.Fatalf(`Error while Calculating Approximate Position Of Planet Jupiter. Required: Rising = %d %d %f  Setting = %d %d %f   Got: Rising = %d %d %f  Setting = %d %d %f`, 10, 58, 21.85, 6, 34, 16.42, raHrs, raMins, raSecs, decDeg, decMin, decSec)
 	}
 }
+
+func TestCalculateApparentPositionOfPlanet(t *testing.T) {
+	// Venus on 1992 December 20 at 0h TD, Meeus gives 21h 04m 41.454s -18 53' 16.84"
+	raHrs, raMins, raSecs, decDeg, decMin, decSec, _, _, distanceAU := planets.CalculateApparentPositionOfPlanet(2448976.5, "Venus")
+	const tolerance = 1.0 // Define an acceptable error range
+
+	if raHrs != 21 || raMins != 4 || math.Abs(raSecs-41.454) > tolerance || decDeg != -18 || decMin != 53 || math.Abs(decSec-16.84) > 5*tolerance || math.Abs(distanceAU-0.910845) > 0.001 {
+		t.Fatalf(`Error while Calculating Apparent Position Of Planet Venus. Required: %d %d %f  %d %d %f   Got: %d %d %f  %d %d %f`, 21, 4, 41.454, -18, 53, 16.84, raHrs, raMins, raSecs, decDeg, decMin, decSec)
+	}
+}
+
+func TestCalculatePhysicalEphemerisOfMars(t *testing.T) {
+	// Meeus example 42.a
+	ephemeris := planets.CalculatePhysicalEphemerisOfMars(2448935.500683)
+	const tolerance = 0.1 // Define an acceptable error range
+
+	if math.Abs(ephemeris.DE-12.44) > tolerance || math.Abs(ephemeris.DS+2.76) > tolerance || math.Abs(ephemeris.CentralMeridian-111.55) > tolerance ||
+		math.Abs(ephemeris.PositionAngle-347.64) > tolerance || math.Abs(ephemeris.PositionAngleOfDefect-279.91) > tolerance {
+		t.Fatalf(`Error while Calculating Physical Ephemeris Of Mars. Required: %f %f %f %f %f Got: %f %f %f %f %f`, 12.44, -2.76, 111.55, 347.64, 279.91, ephemeris.DE, ephemeris.DS, ephemeris.CentralMeridian, ephemeris.PositionAngle, ephemeris.PositionAngleOfDefect)
+	}
+	if math.Abs(ephemeris.ApparentDiameter-10.75) > tolerance || math.Abs(ephemeris.IlluminatedFraction-0.9012) > 0.001 || math.Abs(ephemeris.DefectOfIllumination-1.06) > tolerance {
+		t.Fatalf(`Error while Calculating Illumination Of Mars. Required: %f %f %f Got: %f %f %f`, 10.75, 0.9012, 1.06, ephemeris.ApparentDiameter, ephemeris.IlluminatedFraction, ephemeris.DefectOfIllumination)
+	}
+}
+
+func TestCalculatePhysicalEphemerisOfJupiter(t *testing.T) {
+	// Meeus example 43.a, rigorous method
+	ephemeris := planets.CalculatePhysicalEphemerisOfJupiter(2448972.50068)
+	const tolerance = 0.01 // Define an acceptable error range
+
+	if math.Abs(ephemeris.DS+2.20) > tolerance || math.Abs(ephemeris.DE+2.48) > tolerance || math.Abs(ephemeris.CentralMeridianSystemI-268.06) > tolerance ||
+		math.Abs(ephemeris.CentralMeridianSystemII-72.74) > tolerance || math.Abs(ephemeris.PositionAngle-24.80) > tolerance {
+		t.Fatalf(`Error while Calculating Physical Ephemeris Of Jupiter. Required: %f %f %f %f %f Got: %f %f %f %f %f`, -2.20, -2.48, 268.06, 72.74, 24.80, ephemeris.DS, ephemeris.DE, ephemeris.CentralMeridianSystemI, ephemeris.CentralMeridianSystemII, ephemeris.PositionAngle)
+	}
+}
@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"go-astronomy/internal/coords"
+	"math"
+	"testing"
+)
+
+func compareMatrices(t *testing.T, name string, got, required [3][3]float64, tolerance float64) {
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if math.Abs(got[i][j]-required[i][j]) > tolerance {
+				t.Fatalf("Error while Calculating %s. Required: [%d][%d] %.16f Got: %.16f", name, i, j, required[i][j], got[i][j])
+			}
+		}
+	}
+}
+
+func TestCalculatePrecessionAnglesIAU1976(t *testing.T) {
+	// SOFA iauPrec76 from MJD 33282.0 to 51544.0, in radians
+	const tolerance = 1e-12 // Define an acceptable error range
+
+	zeta, z, theta := coords.CalculatePrecessionAnglesIAU1976(2400000.5+33282.0, 2400000.5+51544.0)
+	zeta, z, theta = zeta*math.Pi/180, z*math.Pi/180, theta*math.Pi/180
+	if math.Abs(zeta-0.5588961642000161243e-2) > tolerance || math.Abs(z-0.5589922365870680624e-2) > tolerance || math.Abs(theta-0.4858945471687296760e-2) > tolerance {
+		t.Fatalf("Error while Calculating Precession Angles. Required: %.16f %.16f %.16f Got: %.16f %.16f %.16f", 0.5588961642000161243e-2, 0.5589922365870680624e-2, 0.4858945471687296760e-2, zeta, z, theta)
+	}
+}
+
+func TestCalculatePrecessionMatrixIAU1976(t *testing.T) {
+	// SOFA iauPmat76 for MJD 50123.9999 TT
+	compareMatrices(t, "Precession Matrix IAU 1976", coords.CalculatePrecessionMatrixIAU1976(2451545.0, 2400000.5+50123.9999), [3][3]float64{
+		{0.9999995504328350733, 0.8696632209480960785e-3, 0.3779153474959888345e-3},
+		{-0.8696632209485112192e-3, 0.9999996218428560614, -0.1643284776111886407e-6},
+		{-0.3779153474950336749e-3, -0.1643306746147366896e-6, 0.9999999285899790119},
+	}, 1e-12)
+
+	// theta Persei from J2000.0 to 2028 November 13.19 TD (Meeus example 21.b)
+	const tolerance = 0.000001 // Define an acceptable error range
+	matrix := coords.CalculatePrecessionMatrixIAU1976(2451545.0, 2462088.69)
+	ra, dec := 41.054063*math.Pi/180, 49.227750*math.Pi/180
+	v := [3]float64{math.Cos(dec) * math.Cos(ra), math.Cos(dec) * math.Sin(ra), math.Sin(dec)}
+	w := [3]float64{}
+	for i := range w {
+		w[i] = (matrix[i][0] * v[0]) + (matrix[i][1] * v[1]) + (matrix[i][2] * v[2])
+	}
+	precessedRA, precessedDec := math.Atan2(w[1], w[0])*180/math.Pi, math.Asin(w[2])*180/math.Pi
+	if math.Abs(precessedRA-41.547214) > tolerance || math.Abs(precessedDec-49.348483) > tolerance {
+		t.Fatalf("Error while Calculating Precession Matrix IAU 1976. Required: %f %f Got: %f %f", 41.547214, 49.348483, precessedRA, precessedDec)
+	}
+}
+
+func TestCalculateFukushimaWilliamsAngles(t *testing.T) {
+	// SOFA iauPfw06 for MJD 50123.9999 TT, in radians
+	const tolerance = 1e-12 // Define an acceptable error range
+
+	gammaBar, phiBar, psiBar, epsilonA := coords.CalculateFukushimaWilliamsAngles(2400000.5 + 50123.9999)
+	got := [4]float64{gammaBar * math.Pi / 180, phiBar * math.Pi / 180, psiBar * math.Pi / 180, epsilonA * math.Pi / 180}
+	required := [4]float64{-0.2243387670997995690e-5, 0.4091014602391312808, -0.9501954178013031895e-3, 0.4091014316587367491}
+	for i := range got {
+		if math.Abs(got[i]-required[i]) > tolerance {
+			t.Fatalf("Error while Calculating Fukushima Williams Angles. Required: %.16f Got: %.16f", required[i], got[i])
+		}
+	}
+}
+
+func TestCalculateBiasPrecessionMatrixIAU2006(t *testing.T) {
+	// SOFA iauPmat06 for MJD 50123.9999 TT
+	compareMatrices(t, "Bias Precession Matrix IAU 2006", coords.CalculateBiasPrecessionMatrixIAU2006(2400000.5+50123.9999), [3][3]float64{
+		{0.9999995505176007047, 0.8695404617348208406e-3, 0.3779735201865589104e-3},
+		{-0.8695404723772031414e-3, 0.9999996219496027161, -0.1361752497080270143e-6},
+		{-0.3779734957034089490e-3, -0.1924880848087615651e-6, 0.9999999285679971958},
+	}, 1e-12)
+
+	// Between two dates the frame bias cancels and the result is close to the IAU 1976 model
+	compareMatrices(t, "Precession Matrix IAU 2006", coords.CalculatePrecessionMatrixIAU2006(2451545.0, 2462088.69), coords.CalculatePrecessionMatrixIAU1976(2451545.0, 2462088.69), 1e-6)
+}
+
+func TestEquatorialCoordinatesPrecess(t *testing.T) {
+	const tolerance = 0.2 / 3600 // Define an acceptable error range
+
+	// theta Persei (Meeus example 21.b), the IAU 2006 model differs from the IAU 1976 one by about 0.1"
+	precessed := coords.EquatorialCoordinates{RADecimalHrs: 41.054063 / 15, DecDecimalDeg: 49.227750}.Precess(2451545.0, 2462088.69)
+	if math.Abs(precessed.RADecimalHrs*15-41.547214) > tolerance || math.Abs(precessed.DecDecimalDeg-49.348483) > tolerance {
+		t.Fatalf("Error while Calculating Precession. Required: %f %f Got: %f %f", 41.547214, 49.348483, precessed.RADecimalHrs*15, precessed.DecDecimalDeg)
+	}
+
+	// Next to the pole and back again over two thousand years
+	polaris := coords.EquatorialCoordinates{RADecimalHrs: 2.530195, DecDecimalDeg: 89.264109}
+	back := polaris.Precess(2451545.0, 2451545.0+730500).Precess(2451545.0+730500, 2451545.0)
+	if math.Abs(back.RADecimalHrs-polaris.RADecimalHrs)*15*math.Cos(polaris.DecDecimalDeg*math.Pi/180) > 1e-9 || math.Abs(back.DecDecimalDeg-polaris.DecDecimalDeg) > 1e-9 {
+		t.Fatalf("Error while Calculating Precession. Required: %f %f Got: %f %f", polaris.RADecimalHrs, polaris.DecDecimalDeg, back.RADecimalHrs, back.DecDecimalDeg)
+	}
+}
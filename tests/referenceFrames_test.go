@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"errors"
+	"go-astronomy/internal/coords"
+	datetime "go-astronomy/internal/dateTime"
+	"math"
+	"testing"
+)
+
+func compareEquatorialRadians(t *testing.T, name string, got coords.EquatorialCoordinates, raRad, decRad, tolerance float64) {
+	gotRA, gotDec := got.RADecimalHrs*15*math.Pi/180, got.DecDecimalDeg*math.Pi/180
+	if math.Abs(gotRA-raRad) > tolerance || math.Abs(gotDec-decRad) > tolerance {
+		t.Fatalf("Error while Converting %s. Required: %.16f %.16f Got: %.16f %.16f", name, raRad, decRad, gotRA, gotDec)
+	}
+}
+
+func radiansToEquatorial(raRad, decRad float64, frame coords.ReferenceFrame) coords.EquatorialCoordinates {
+	return coords.EquatorialCoordinates{RADecimalHrs: raRad * 180 / math.Pi / 15, DecDecimalDeg: decRad * 180 / math.Pi, Frame: frame}
+}
+
+func TestConvertJulianDateToBesselianEpoch(t *testing.T) {
+	const tolerance = 0.000001 // Define an acceptable error range
+
+	if epoch := datetime.ConvertJulianDateToBesselianEpoch(2433282.4235); math.Abs(epoch-1950.0) > tolerance {
+		t.Fatalf("Error while Converting Besselian Epoch. Required: %f Got: %f", 1950.0, epoch)
+	}
+	if epoch := datetime.ConvertJulianDateToJulianEpoch(2451545.0); epoch != 2000.0 {
+		t.Fatalf("Error while Converting Julian Epoch. Required: %f Got: %f", 2000.0, epoch)
+	}
+	if julianDate := datetime.ConvertBesselianEpochToJulianDate(datetime.ConvertJulianDateToBesselianEpoch(2460000.5)); math.Abs(julianDate-2460000.5) > tolerance {
+		t.Fatalf("Error while Converting Besselian Epoch. Required: %f Got: %f", 2460000.5, julianDate)
+	}
+}
+
+func TestConvertFK5ToICRS(t *testing.T) {
+	// SOFA iauFk52h at J2000.0 and iauFk5hz at MJD 54479.0, stars without proper motion
+	fk5 := radiansToEquatorial(1.76779433, -0.2917517103, coords.FrameFK5)
+	icrs, err := fk5.ConvertToFrame(coords.FrameICRS, 0, 2451545.0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	compareEquatorialRadians(t, "FK5 To ICRS", icrs, 1.767794226299947632, -0.2917516070530391757, 1e-12)
+
+	icrs, _ = fk5.ConvertToFrame(coords.FrameICRS, 0, 2400000.5+54479.0)
+	compareEquatorialRadians(t, "FK5 To ICRS", icrs, 1.767794191464423978, -0.2917516001679884419, 1e-12)
+
+	back, _ := icrs.ConvertToFrame(coords.FrameFK5, 0, 2400000.5+54479.0)
+	compareEquatorialRadians(t, "ICRS To FK5", back, 1.76779433, -0.2917517103, 1e-12)
+}
+
+func TestConvertFK4ToFK5(t *testing.T) {
+	// SOFA iauFk45z, a star without proper motion in the FK5 observed at B1954.677617625256806
+	epoch := datetime.ConvertBesselianEpochToJulianDate(1954.677617625256806)
+	fk4 := radiansToEquatorial(0.01602284975382960982, -0.1164347929099906024, coords.FrameFK4)
+	fk5, err := fk4.ConvertToFrame(coords.FrameFK5, 0, epoch)
+	if err != nil || fk5.Frame != coords.FrameFK5 {
+		t.Fatalf("Error while Converting FK4 To FK5. Required: %s Got: %s (%v)", coords.FrameFK5, fk5.Frame, err)
+	}
+	compareEquatorialRadians(t, "FK4 To FK5", fk5, 0.02719295911606862303, -0.1115766001565926892, 1e-12)
+
+	back, _ := fk5.ConvertToFrame(coords.FrameFK4, 0, epoch)
+	compareEquatorialRadians(t, "FK5 To FK4", back, 0.01602284975382960982, -0.1164347929099906024, 1e-12)
+
+	// SOFA iauFk54z, whose own matrix inverts the FK4 to FK5 one to a few microarcseconds
+	fk5 = radiansToEquatorial(0.02719026625066316119, -0.1115815170738754813, coords.FrameFK5)
+	fk4, _ = fk5.ConvertToFrame(coords.FrameFK4, 0, datetime.ConvertBesselianEpochToJulianDate(1954.677308160316374))
+	compareEquatorialRadians(t, "FK5 To FK4", fk4, 0.01602015588390065476, -0.1164397101110765346, 1e-10)
+}
+
+func TestConvertToFrame(t *testing.T) {
+	const tolerance = 1e-9 // Define an acceptable error range
+
+	// A B1950 plate position carried to the ICRS and back, and to the mean equator and equinox of 2025
+	plate := coords.EquatorialCoordinates{RADecimalHrs: 5.5, DecDecimalDeg: -5.4, Frame: coords.FrameFK4}
+	icrs, err := plate.ConvertToFrame(coords.FrameICRS, 0, 2433282.4235)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ofDate, _ := icrs.ConvertToFrame(coords.FrameMeanOfDate, 2460676.5, 2433282.4235)
+	back, _ := ofDate.ConvertToFrame(coords.FrameFK4, 0, 2433282.4235)
+	if math.Abs(back.RADecimalHrs-plate.RADecimalHrs)*15 > tolerance || math.Abs(back.DecDecimalDeg-plate.DecDecimalDeg) > tolerance || ofDate.Equinox != 2460676.5 {
+		t.Fatalf("Error while Converting Frames. Required: %f %f Got: %f %f", plate.RADecimalHrs, plate.DecDecimalDeg, back.RADecimalHrs, back.DecDecimalDeg)
+	}
+
+	// Precessed coordinates carry their equinox and the frame bias between the ICRS and J2000.0 is about 0.02"
+	j2000, _ := icrs.ConvertToFrame(coords.FrameMeanOfDate, 2451545.0, 2451545.0)
+	precessed := j2000.Precess(2451545.0, 2460676.5)
+	if precessed.Frame != coords.FrameMeanOfDate || math.Abs(precessed.RADecimalHrs-ofDate.RADecimalHrs)*15 > tolerance || math.Abs(precessed.DecDecimalDeg-ofDate.DecDecimalDeg) > tolerance ||
+		math.Hypot((j2000.RADecimalHrs-icrs.RADecimalHrs)*15*math.Cos(icrs.DecDecimalDeg*math.Pi/180), j2000.DecDecimalDeg-icrs.DecDecimalDeg)*3600 > 0.03 {
+		t.Fatalf("Error while Converting Frames. Required: %f %f Got: %f %f", ofDate.RADecimalHrs, ofDate.DecDecimalDeg, precessed.RADecimalHrs, precessed.DecDecimalDeg)
+	}
+
+	if _, err := (coords.EquatorialCoordinates{RADecimalHrs: 1, DecDecimalDeg: 1}).ConvertToFrame(coords.FrameICRS, 0, 2451545.0); !errors.Is(err, coords.ErrUnknownFrame) {
+		t.Fatalf("Error while Converting Frames. Required: %v Got: %v", coords.ErrUnknownFrame, err)
+	}
+}
@@ -0,0 +1,235 @@
+package tests
+
+import (
+	"go-astronomy/internal/coords"
+	"go-astronomy/internal/satellite"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// Vanguard 1 and Molniya 2-14 from the SGP4 verification set of Vallado et al. (2006)
+const vanguardLine1 = "1 00005U 58002B   00179.78495062  .00000023  00000-0  28098-4 0  4753"
+const vanguardLine2 = "2 00005  34.2682 348.7242 1859667 331.7664  19.3264 10.82419157413667"
+const molniyaLine1 = "1 08195U 75081A   06176.33215444  .00000099  00000-0  11873-3 0   813"
+const molniyaLine2 = "2 08195  64.1586 279.0717 6877146 264.7651  20.2257  2.00491383225656"
+
+func TestParseTLE(t *testing.T) {
+	const tolerance = 0.0000001 // Define an acceptable error range
+
+	tle, err := satellite.ParseTLE("0 VANGUARD 1", vanguardLine1, vanguardLine2)
+	if err != nil {
+		t.Fatalf("Error while Parsing TLE: %v", err)
+	}
+	if tle.Name != "VANGUARD 1" || tle.CatalogNumber != "00005" || tle.InternationalDesignator != "58002B" {
+		t.Fatalf("Error while Parsing TLE. Required: %s %s %s Got: %s %s %s", "VANGUARD 1", "00005", "58002B", tle.Name, tle.CatalogNumber, tle.InternationalDesignator)
+	}
+	if math.Abs(tle.Epoch-2451723.28495062) > tolerance || math.Abs(tle.BStar-0.28098e-4) > tolerance || math.Abs(tle.Eccentricity-0.1859667) > tolerance {
+		t.Fatalf("Error while Parsing TLE. Required: %f %f %f Got: %f %f %f", 2451723.28495062, 0.28098e-4, 0.1859667, tle.Epoch, tle.BStar, tle.Eccentricity)
+	}
+	if math.Abs(tle.MeanMotion-10.82419157) > tolerance || tle.RevolutionNumber != 41366 {
+		t.Fatalf("Error while Parsing TLE. Required: %f %d Got: %f %d", 10.82419157, 41366, tle.MeanMotion, tle.RevolutionNumber)
+	}
+
+	// A changed digit breaks the checksum
+	if _, err := satellite.ParseTLE("", vanguardLine1, "2 00005  34.2682 348.7242 1859667 331.7664  19.3265 10.82419157413667"); err == nil {
+		t.Fatalf("Error while Parsing TLE. Required: a checksum error")
+	}
+}
+
+func TestPropagate(t *testing.T) {
+	// Reference state vectors of the verification set, in km and km/s
+	expected := []struct {
+		line1, line2       string
+		minutes            float64
+		position, velocity [3]float64
+	}{
+		{vanguardLine1, vanguardLine2, 0, [3]float64{7022.46529266, -1400.08296755, 0.03995155}, [3]float64{1.893841015, 6.405893759, 4.534807250}},
+		{vanguardLine1, vanguardLine2, 360, [3]float64{-7154.03120202, -3783.17682504, -3536.19412294}, [3]float64{4.741887409, -4.151817765, -2.093935425}},
+		{molniyaLine1, molniyaLine2, 0, [3]float64{2349.89483350, -14785.93811562, 0.02119378}, [3]float64{2.721488096, -3.256811655, 4.498416672}},
+		{molniyaLine1, molniyaLine2, 120, [3]float64{15223.91713658, -17852.95881713, 25280.39558224}, [3]float64{1.079041732, 0.875187372, 2.485682813}},
+		{molniyaLine1, molniyaLine2, 240, [3]float64{19752.78050009, -8600.07130962, 37522.72921090}, [3]float64{0.238105279, 1.546110924, 0.986410447}},
+		{molniyaLine1, molniyaLine2, 360, [3]float64{19089.29762968, 3107.89495018, 39958.14661370}, [3]float64{-0.410308034, 1.640332277, -0.306873818}},
+	}
+	const tolerance = 0.00001 // Define an acceptable error range
+
+	for _, e := range expected {
+		tle, err := satellite.ParseTLE("", e.line1, e.line2)
+		if err != nil {
+			t.Fatalf("Error while Parsing TLE: %v", err)
+		}
+		model, err := satellite.InitializeSGP4(tle)
+		if err != nil {
+			t.Fatalf("Error while Initializing SGP4: %v", err)
+		}
+		state, err := model.Propagate(e.minutes)
+		if err != nil {
+			t.Fatalf("Error while Propagating: %v", err)
+		}
+		for i := 0; i < 3; i++ {
+			if math.Abs(state.Position[i]-e.position[i]) > tolerance || math.Abs(state.Velocity[i]-e.velocity[i]) > tolerance {
+				t.Fatalf("Error while Propagating %s. Required: %v %v Got: %v %v", tle.CatalogNumber, e.position, e.velocity, state.Position, state.Velocity)
+			}
+		}
+	}
+}
+
+func TestPropagateGeostationary(t *testing.T) {
+	// A geostationary satellite over 3.5 degrees west, propagated through the 24 hour resonance terms of SDP4,
+	// keeps its longitude and its distance from the centre of the Earth
+	const tolerance = 0.05 // Define an acceptable error range
+
+	tle, err := satellite.ParseTLE("", "1 99999U 06001A   06176.50000000  .00000000  00000-0  00000-0 0  9997", "2 99999   0.0500  90.0000 0002000   0.0000   0.0000  1.00273791    14")
+	if err != nil {
+		t.Fatalf("Error while Parsing TLE: %v", err)
+	}
+	model, err := satellite.InitializeSGP4(tle)
+	if err != nil {
+		t.Fatalf("Error while Initializing SGP4: %v", err)
+	}
+	longitudes := []float64{}
+	for _, minutes := range []float64{0, 1440, 2880} {
+		state, err := model.Propagate(minutes)
+		if err != nil {
+			t.Fatalf("Error while Propagating: %v", err)
+		}
+		earthFixed := satellite.ConvertTEMEToEarthFixed(state, tle.Epoch+(minutes/1440))
+		distance := math.Sqrt(math.Pow(earthFixed.Position[0], 2) + math.Pow(earthFixed.Position[1], 2) + math.Pow(earthFixed.Position[2], 2))
+		if math.Abs(distance-42164) > 20 {
+			t.Fatalf("Error while Propagating a Geostationary Satellite. Required: %f Got: %f", 42164.0, distance)
+		}
+		longitudes = append(longitudes, math.Atan2(earthFixed.Position[1], earthFixed.Position[0])*180/math.Pi)
+	}
+	if math.Abs(longitudes[1]-longitudes[0]) > tolerance || math.Abs(longitudes[2]-longitudes[0]) > tolerance {
+		t.Fatalf("Error while Propagating a Geostationary Satellite. Required: %f Got: %v", longitudes[0], longitudes)
+	}
+}
+
+func TestConvertTEMEToTopocentric(t *testing.T) {
+	// At the epoch Vanguard 1 is over the equator, so an observer at its sub-satellite point sees it at the zenith
+	tle, _ := satellite.ParseTLE("", vanguardLine1, vanguardLine2)
+	model, _ := satellite.InitializeSGP4(tle)
+	state, _ := satellite.CalculateTEMEStateVector(model, tle.Epoch)
+	earthFixed := satellite.ConvertTEMEToEarthFixed(state, tle.Epoch)
+	longitude := math.Atan2(earthFixed.Position[1], earthFixed.Position[0]) * 180 / math.Pi
+	const tolerance = 0.01 // Define an acceptable error range
+
+	position := satellite.ConvertTEMEToTopocentric(state, tle.Epoch, coords.Observer{GeoLong: longitude})
+	distance := math.Sqrt(math.Pow(state.Position[0], 2)+math.Pow(state.Position[1], 2)+math.Pow(state.Position[2], 2)) - 6378.137
+	if math.Abs(position.Elevation-90) > tolerance || math.Abs(position.Range-distance) > tolerance {
+		t.Fatalf("Error while Calculating Topocentric Position. Required: %f %f Got: %f %f", 90.0, distance, position.Elevation, position.Range)
+	}
+
+	// Seen from the antipode the satellite is below the horizon
+	position, err := satellite.CalculateTopocentricPosition(model, tle.Epoch, coords.Observer{GeoLong: longitude + 180})
+	if err != nil || position.Elevation > -80 {
+		t.Fatalf("Error while Calculating Topocentric Position. Required: below %f Got: %f (%v)", -80.0, position.Elevation, err)
+	}
+}
+
+func TestReadTLEFile(t *testing.T) {
+	// Two and three line element sets may be mixed in one file
+	path := filepath.Join(t.TempDir(), "elements.txt")
+	content := "VANGUARD 1\n" + vanguardLine1 + "\n" + vanguardLine2 + "\n" + molniyaLine1 + "\r\n" + molniyaLine2 + "\r\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	elementSets, err := satellite.ReadTLEFile(path)
+	if err != nil || len(elementSets) != 2 || elementSets[0].Name != "VANGUARD 1" || elementSets[1].CatalogNumber != "08195" {
+		t.Fatalf("Error while Reading TLE File. Required: %d element sets Got: %d (%v)", 2, len(elementSets), err)
+	}
+}
+
+func TestIsSatelliteSunlit(t *testing.T) {
+	// Satellites on the day side are lit, low ones straight behind the Earth are not and beyond the tip of the
+	// umbra, about 1.4 million km away, they are lit again
+	const julianDate = 2451723.5 // 2000 June 27, the Sun is near RA 6h 24m, Dec +23.3
+	sunward := satellite.StateVector{Position: [3]float64{0, 7000, 0}}
+	behind := satellite.StateVector{Position: [3]float64{0, -7000, -3000}}
+	farBehind := satellite.StateVector{Position: [3]float64{0, -1.5e6, -0.65e6}}
+	if !satellite.IsSatelliteSunlit(sunward, julianDate) || satellite.IsSatelliteSunlit(behind, julianDate) || !satellite.IsSatelliteSunlit(farBehind, julianDate) {
+		t.Fatalf("Error while Calculating Illumination. Required: %v %v %v Got: %v %v %v", true, false, true,
+			satellite.IsSatelliteSunlit(sunward, julianDate), satellite.IsSatelliteSunlit(behind, julianDate), satellite.IsSatelliteSunlit(farBehind, julianDate))
+	}
+}
+
+func TestCalculateSunAltitude(t *testing.T) {
+	// Near noon at Greenwich on the June solstice the Sun stands 90 - 51.48 + 23.44 degrees high
+	const tolerance = 0.05 // Define an acceptable error range
+
+	if altitude := satellite.CalculateSunAltitude(2460483.0, coords.Observer{GeoLatN: 51.4769}); math.Abs(altitude-61.96) > tolerance {
+		t.Fatalf("Error while Calculating Sun Altitude. Required: %f Got: %f", 61.96, altitude)
+	}
+}
+
+func TestCalculateSatellitePasses(t *testing.T) {
+	// Passes of Vanguard 1 over an observer at 35 degrees south on the day after the epoch. The first three
+	// passes of the night are visible, the later ones take place in the shadow of the Earth.
+	tle, _ := satellite.ParseTLE("", vanguardLine1, vanguardLine2)
+	model, _ := satellite.InitializeSGP4(tle)
+	const minElevation = 10.0
+	observer := coords.Observer{GeoLatN: -35, GeoLong: -75}
+	const tolerance = 0.01 // Define an acceptable error range
+
+	passes, err := satellite.CalculateSatellitePasses(model, tle.Epoch, tle.Epoch+1, observer, minElevation)
+	if err != nil || len(passes) != 5 {
+		t.Fatalf("Error while Calculating Satellite Passes. Required: %d passes Got: %d (%v)", 5, len(passes), err)
+	}
+	for i, pass := range passes {
+		rise, _ := satellite.CalculateTopocentricPosition(model, pass.Rise, observer)
+		if !(pass.Rise < pass.Culmination && pass.Culmination < pass.Set) || math.Abs(rise.Elevation-minElevation) > tolerance || pass.MaxElevation < minElevation {
+			t.Fatalf("Error while Calculating Satellite Pass %d. Got: %+v", i, pass)
+		}
+		if pass.IsVisible != (i < 3) || pass.IsSunlit != (i < 3) || !pass.IsObserverInDarkness {
+			t.Fatalf("Error while Calculating Visibility of Pass %d. Required: %v Got: %v %v %v", i, i < 3, pass.IsVisible, pass.IsSunlit, pass.IsObserverInDarkness)
+		}
+	}
+	if math.Abs(passes[2].MaxElevation-85.7) > 0.1 {
+		t.Fatalf("Error while Calculating Satellite Passes. Required: %f Got: %f", 85.7, passes[2].MaxElevation)
+	}
+
+	// Rise, culmination and set against a scan of the elevation at 1 s steps, computed here from the TEME
+	// position with the IAU 1982 sidereal time and the local vertical of the WGS-84 ellipsoid
+	const second = 1.0 / 86400
+	const scanTolerance = 2 * second
+	lat, long := observer.GeoLatN*math.Pi/180, observer.GeoLong*math.Pi/180
+	eSquared := (2 - (1 / 298.257223563)) / 298.257223563
+	N := 6378.137 / math.Sqrt(1-(eSquared*math.Pow(math.Sin(lat), 2)))
+	site := [3]float64{N * math.Cos(lat) * math.Cos(long), N * math.Cos(lat) * math.Sin(long), N * (1 - eSquared) * math.Sin(lat)}
+	up := [3]float64{math.Cos(lat) * math.Cos(long), math.Cos(lat) * math.Sin(long), math.Sin(lat)}
+	elevationAt := func(julianDate float64) float64 {
+		state, _ := satellite.CalculateTEMEStateVector(model, julianDate)
+		T := (julianDate - 2451545.0) / 36525.0
+		gmst := math.Mod(67310.54841+(((876600*3600)+8640184.812866)*T)+(0.093104*T*T)-(6.2e-6*T*T*T), 86400) * 2 * math.Pi / 86400
+		x := (math.Cos(gmst) * state.Position[0]) + (math.Sin(gmst) * state.Position[1]) - site[0]
+		y := (-math.Sin(gmst) * state.Position[0]) + (math.Cos(gmst) * state.Position[1]) - site[1]
+		z := state.Position[2] - site[2]
+		return math.Asin(((x*up[0])+(y*up[1])+(z*up[2]))/math.Sqrt((x*x)+(y*y)+(z*z))) * 180 / math.Pi
+	}
+	scanned := []satellite.SatellitePass{}
+	for julianDate := tle.Epoch; julianDate < passes[len(passes)-1].Set+(60*second); julianDate += second {
+		elevation := elevationAt(julianDate)
+		if elevation < minElevation {
+			if len(scanned) > 0 && scanned[len(scanned)-1].Set == 0 {
+				scanned[len(scanned)-1].Set = julianDate
+			}
+			continue
+		}
+		if len(scanned) == 0 || scanned[len(scanned)-1].Set != 0 {
+			scanned = append(scanned, satellite.SatellitePass{Rise: julianDate})
+		}
+		if pass := &scanned[len(scanned)-1]; elevation > pass.MaxElevation {
+			pass.Culmination, pass.MaxElevation = julianDate, elevation
+		}
+	}
+	if len(scanned) != len(passes) {
+		t.Fatalf("Error while Scanning Satellite Passes. Required: %d passes Got: %d", len(passes), len(scanned))
+	}
+	for i, pass := range passes {
+		if math.Abs(pass.Rise-scanned[i].Rise) > scanTolerance || math.Abs(pass.Culmination-scanned[i].Culmination) > scanTolerance ||
+			math.Abs(pass.Set-scanned[i].Set) > scanTolerance || math.Abs(pass.MaxElevation-scanned[i].MaxElevation) > tolerance {
+			t.Fatalf("Error while Calculating Satellite Pass %d. Required: %f %f %f %f Got: %f %f %f %f", i, scanned[i].Rise, scanned[i].Culmination, scanned[i].Set, scanned[i].MaxElevation, pass.Rise, pass.Culmination, pass.Set, pass.MaxElevation)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package tests
+
+import (
+	datetime "go-astronomy/internal/dateTime"
+	"go-astronomy/internal/saturn"
+	"math"
+	"testing"
+)
+
+func TestCalculateRingOfSaturn(t *testing.T) {
+	// Meeus example 45.a
+	const tolerance = 0.001 // Define an acceptable error range
+	const axisTolerance = 0.01
+
+	ring := saturn.CalculateRingOfSaturn(2448972.50068)
+	if math.Abs(ring.B-16.442) > tolerance || math.Abs(ring.BDash-14.679) > tolerance || math.Abs(ring.DeltaU-4.198) > tolerance || math.Abs(ring.PositionAngle-6.741) > tolerance {
+		t.Fatalf("Error while Calculating Ring of Saturn. Required: %f %f %f %f Got: %f %f %f %f", 16.442, 14.679, 4.198, 6.741, ring.B, ring.BDash, ring.DeltaU, ring.PositionAngle)
+	}
+	if math.Abs(ring.MajorAxis-35.87) > axisTolerance || math.Abs(ring.MinorAxis-10.15) > axisTolerance {
+		t.Fatalf("Error while Calculating Axes of the Ring of Saturn. Required: %f %f Got: %f %f", 35.87, 10.15, ring.MajorAxis, ring.MinorAxis)
+	}
+
+	// The Earth crossed the plane of the ring on 2009 September 4, while B changes by about 0.06 degrees a day
+	const crossingTolerance = 0.05
+	ring = saturn.CalculateRingOfSaturn(datetime.ConvertGreenwichDateToJulianDate(4, 9, 2009))
+	if math.Abs(ring.B) > crossingTolerance {
+		t.Fatalf("Error while Calculating Ring of Saturn. Required: %f Got: %f", 0.0, ring.B)
+	}
+}
+
+func TestCalculatePositionsOfSatellitesOfSaturn(t *testing.T) {
+	// Meeus example 46.a
+	expected := [8][2]float64{{3.102, -0.204}, {3.823, 0.318}, {4.027, -1.061}, {-5.365, -1.148}, {-0.972, -3.136}, {14.568, 4.738}, {-18.001, -5.328}, {-48.760, 4.137}}
+	const tolerance = 0.001 // Define an acceptable error range
+
+	positions := saturn.CalculatePositionsOfSatellites(2451439.50074)
+	for i, position := range positions {
+		if math.Abs(position.X-expected[i][0]) > tolerance || math.Abs(position.Y-expected[i][1]) > tolerance {
+			t.Fatalf("Error while Calculating Position of %s. Required: %f %f Got: %f %f", saturn.SatelliteNames[i], expected[i][0], expected[i][1], position.X, position.Y)
+		}
+	}
+}
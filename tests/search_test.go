@@ -0,0 +1,35 @@
+package tests
+
+import (
+	"go-astronomy/internal/macros"
+	"math"
+	"testing"
+)
+
+func TestFindRoot(t *testing.T) {
+	const tolerance = 1e-12 // Define an acceptable error range
+
+	if root := macros.FindRoot(math.Cos, 0, 3, tolerance); math.Abs(root-(math.Pi/2)) > tolerance {
+		t.Fatalf("Error while Finding Root. Required: %f Got: %f", math.Pi/2, root)
+	}
+	if change := macros.FindTransition(func(x float64) bool { return x*x > 2 }, 2, 0, tolerance); math.Abs(change-math.Sqrt2) > tolerance {
+		t.Fatalf("Error while Finding Transition. Required: %f Got: %f", math.Sqrt2, change)
+	}
+
+	// A tolerance finer than the spacing of Julian dates ends at the precision of float64
+	julianDate := 2451545.123456789
+	if change := macros.FindTransition(func(x float64) bool { return x >= julianDate }, julianDate-1, julianDate+1, 0); math.Abs(change-julianDate) > 1e-9 {
+		t.Fatalf("Error while Finding Transition. Required: %f Got: %f", julianDate, change)
+	}
+}
+
+func TestFindMinimum(t *testing.T) {
+	const tolerance = 1e-6 // Define an acceptable error range
+
+	if minimum := macros.FindMinimum(func(x float64) float64 { return math.Pow(x-1.25, 2) }, -3, 4, 1e-9); math.Abs(minimum-1.25) > tolerance {
+		t.Fatalf("Error while Finding Minimum. Required: %f Got: %f", 1.25, minimum)
+	}
+	if maximum := macros.FindMinimum(func(x float64) float64 { return -math.Sin(x) }, 0, 3, 1e-9); math.Abs(maximum-(math.Pi/2)) > tolerance {
+		t.Fatalf("Error while Finding Maximum. Required: %f Got: %f", math.Pi/2, maximum)
+	}
+}
@@ -0,0 +1,271 @@
+package tests
+
+import (
+	"errors"
+	skyindex "go-astronomy/internal/skyIndex"
+	starcatalog "go-astronomy/internal/starCatalog"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func generateRandomSkyPoints(count int) []skyindex.SkyPoint {
+	// Points spread evenly over the sphere, the same on every run
+	random := rand.New(rand.NewSource(1))
+	points := make([]skyindex.SkyPoint, count)
+	for i := range points {
+		points[i] = skyindex.SkyPoint{RADecimalHrs: 24 * random.Float64(), DecDecimalDeg: math.Asin((2*random.Float64())-1) * 180 / math.Pi}
+	}
+	return points
+}
+
+func calculateSkyPointDistance(a, b skyindex.SkyPoint) float64 {
+	// Angle between two points in decimal degrees by the haversine formula
+	dec1, dec2 := a.DecDecimalDeg*math.Pi/180, b.DecDecimalDeg*math.Pi/180
+	dRA := (a.RADecimalHrs - b.RADecimalHrs) * 15 * math.Pi / 180
+	h := math.Pow(math.Sin((dec2-dec1)/2), 2) + (math.Cos(dec1) * math.Cos(dec2) * math.Pow(math.Sin(dRA/2), 2))
+	return 2 * math.Asin(math.Sqrt(h)) * 180 / math.Pi
+}
+
+func TestConvertDecimalDegToHEALPixel(t *testing.T) {
+	const tolerance = 0.000001 // Define an acceptable error range
+
+	// Base pixels 0, 4 and 8 are centred on 45° +41.8°, 0° 0° and 45° -41.8°
+	centres := map[int64][2]float64{0: {45, 41.810315}, 4: {0, 0}, 8: {45, -41.810315}}
+	for pixel, centre := range centres {
+		ra, dec := skyindex.ConvertHEALPixelToDecimalDeg(pixel, 0)
+		if math.Abs(ra-centre[0]) > tolerance || math.Abs(dec-centre[1]) > tolerance {
+			t.Fatalf("Error while Calculating HEALPixel Centre. Required: %f %f Got: %f %f", centre[0], centre[1], ra, dec)
+		}
+	}
+
+	// NESTED pixel numbers from the ang2pix_nest routine of the HEALPix reference implementation
+	known := []struct {
+		ra, dec float64
+		order   int
+		pixel   int64
+	}{{10, 20, 1, 19}, {200, -30, 1, 42}, {359.9, 89.9, 3, 255}, {123.4, -56.7, 4, 2362}, {300, -80, 7, 180809}, {250.1, 75.3, 10, 3097961}, {45.5, 0.2, 12, 95071755}}
+	for _, point := range known {
+		if got := skyindex.ConvertDecimalDegToHEALPixel(point.ra, point.dec, point.order); got != point.pixel {
+			t.Fatalf("Error while Calculating HEALPixel. Required: %d Got: %d", point.pixel, got)
+		}
+	}
+
+	// Every pixel contains its own centre
+	for _, order := range []int{1, 4, 10} {
+		for pixel := int64(0); pixel < 12<<(2*order); pixel += 1 + int64(order*order*order) {
+			ra, dec := skyindex.ConvertHEALPixelToDecimalDeg(pixel, order)
+			if got := skyindex.ConvertDecimalDegToHEALPixel(ra, dec, order); got != pixel {
+				t.Fatalf("Error while Calculating HEALPixel. Required: %d Got: %d", pixel, got)
+			}
+		}
+	}
+
+	// Every point is within the largest pixel radius of the centre of its pixel
+	for _, point := range generateRandomSkyPoints(2000) {
+		pixel := skyindex.ConvertDecimalDegToHEALPixel(point.RADecimalHrs*15, point.DecDecimalDeg, 5)
+		ra, dec := skyindex.ConvertHEALPixelToDecimalDeg(pixel, 5)
+		if distance := calculateSkyPointDistance(point, skyindex.SkyPoint{RADecimalHrs: ra / 15, DecDecimalDeg: dec}); distance > skyindex.CalculateHEALPixelMaxRadius(5) {
+			t.Fatalf("Error while Calculating HEALPixel. Required: less than %f Got: %f", skyindex.CalculateHEALPixelMaxRadius(5), distance)
+		}
+	}
+}
+
+func TestQueryCone(t *testing.T) {
+	const tolerance = 0.000000001 // Define an acceptable error range
+
+	points := generateRandomSkyPoints(20000)
+	index, err := skyindex.BuildSpatialIndex(points, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cones := []skyindex.SkyPoint{{RADecimalHrs: 6.75, DecDecimalDeg: -16.7}, {RADecimalHrs: 23.95, DecDecimalDeg: 0.5}, {RADecimalHrs: 2.5, DecDecimalDeg: 89.3}}
+	for _, centre := range cones {
+		for _, radius := range []float64{0.5, 3, 40} {
+			matches := index.QueryCone(centre, radius)
+			expected := []int{}
+			for i, point := range points {
+				if calculateSkyPointDistance(centre, point) <= radius {
+					expected = append(expected, i)
+				}
+			}
+			found := []int{}
+			for i, match := range matches {
+				found = append(found, match.Index)
+				if math.Abs(match.Distance-calculateSkyPointDistance(centre, points[match.Index])) > tolerance || (i > 0 && match.Distance < matches[i-1].Distance) {
+					t.Fatalf("Error while Calculating Cone Search. Required: sorted distances Got: %f", match.Distance)
+				}
+			}
+			sort.Ints(found)
+			if len(found) != len(expected) {
+				t.Fatalf("Error while Calculating Cone Search. Required: %d points Got: %d", len(expected), len(found))
+			}
+			for i := range found {
+				if found[i] != expected[i] {
+					t.Fatalf("Error while Calculating Cone Search. Required: %d Got: %d", expected[i], found[i])
+				}
+			}
+		}
+	}
+
+	if _, err := skyindex.BuildSpatialIndex(points, 30); !errors.Is(err, skyindex.ErrInvalidOrder) {
+		t.Fatalf("Error while Building Spatial Index. Required: %v Got: %v", skyindex.ErrInvalidOrder, err)
+	}
+}
+
+func TestQueryNearest(t *testing.T) {
+	points := generateRandomSkyPoints(20000)
+	index, _ := skyindex.BuildSpatialIndex(points, 8)
+	centre := skyindex.SkyPoint{RADecimalHrs: 12.3, DecDecimalDeg: -45.6}
+	distances := make([]float64, len(points))
+	for i, point := range points {
+		distances[i] = calculateSkyPointDistance(centre, point)
+	}
+	sort.Float64s(distances)
+
+	matches := index.QueryNearest(centre, 10)
+	if len(matches) != 10 {
+		t.Fatalf("Error while Calculating Nearest Neighbours. Required: %d Got: %d", 10, len(matches))
+	}
+	for i, match := range matches {
+		if math.Abs(match.Distance-distances[i]) > 0.000000001 {
+			t.Fatalf("Error while Calculating Nearest Neighbours. Required: %f Got: %f", distances[i], match.Distance)
+		}
+	}
+	if all := index.QueryNearest(centre, 30000); len(all) != len(points) {
+		t.Fatalf("Error while Calculating Nearest Neighbours. Required: %d Got: %d", len(points), len(all))
+	}
+}
+
+func TestQueryBox(t *testing.T) {
+	points := generateRandomSkyPoints(20000)
+	index, _ := skyindex.BuildSpatialIndex(points, 7)
+	boxes := [][4]float64{{5, 7, -20, -10}, {23, 1, -5, 5}, {0, 24, 80, 90}, {10, 10.5, -90, -60}}
+	for _, box := range boxes {
+		found := index.QueryBox(box[0], box[1], box[2], box[3])
+		expected := []int{}
+		for i, point := range points {
+			isInRA := point.RADecimalHrs >= box[0] && point.RADecimalHrs <= box[1]
+			if box[0] > box[1] {
+				isInRA = point.RADecimalHrs >= box[0] || point.RADecimalHrs <= box[1]
+			}
+			if isInRA && point.DecDecimalDeg >= box[2] && point.DecDecimalDeg <= box[3] {
+				expected = append(expected, i)
+			}
+		}
+		if len(found) != len(expected) {
+			t.Fatalf("Error while Calculating Box Search. Required: %d points Got: %d", len(expected), len(found))
+		}
+		for i := range found {
+			if found[i] != expected[i] {
+				t.Fatalf("Error while Calculating Box Search. Required: %d Got: %d", expected[i], found[i])
+			}
+		}
+	}
+}
+
+func TestQueryPolygon(t *testing.T) {
+	points := generateRandomSkyPoints(20000)
+	index, _ := skyindex.BuildSpatialIndex(points, 7)
+
+	// A square of 10 degrees across 0h and the same square with the vertices in the other order
+	square := []skyindex.SkyPoint{{RADecimalHrs: 23.7, DecDecimalDeg: -5}, {RADecimalHrs: 0.3, DecDecimalDeg: -5}, {RADecimalHrs: 0.3, DecDecimalDeg: 5}, {RADecimalHrs: 23.7, DecDecimalDeg: 5}}
+	reversed := []skyindex.SkyPoint{square[3], square[2], square[1], square[0]}
+	found, err := index.QueryPolygon(square)
+	if err != nil {
+		t.Fatal(err)
+	}
+	foundReversed, _ := index.QueryPolygon(reversed)
+	expected := []int{}
+	for i, point := range points {
+		// The sides of constant right ascension are great circles, within 5 degrees of the equator those of
+		// constant declination bulge by less than 0.01 degrees
+		if (point.RADecimalHrs >= 23.7 || point.RADecimalHrs <= 0.3) && math.Abs(point.DecDecimalDeg) <= 4.99 {
+			expected = append(expected, i)
+		}
+	}
+	if len(found) != len(foundReversed) || len(found) < len(expected) || len(found) > len(expected)+len(expected)/50 {
+		t.Fatalf("Error while Calculating Polygon Search. Required: about %d points Got: %d %d", len(expected), len(found), len(foundReversed))
+	}
+
+	// A concave outline is refused
+	concave := []skyindex.SkyPoint{{RADecimalHrs: 1, DecDecimalDeg: 0}, {RADecimalHrs: 2, DecDecimalDeg: 0}, {RADecimalHrs: 1.5, DecDecimalDeg: 2}, {RADecimalHrs: 2, DecDecimalDeg: 10}, {RADecimalHrs: 1, DecDecimalDeg: 10}}
+	if _, err := index.QueryPolygon(concave); !errors.Is(err, skyindex.ErrInvalidPolygon) {
+		t.Fatalf("Error while Calculating Polygon Search. Required: %v Got: %v", skyindex.ErrInvalidPolygon, err)
+	}
+}
+
+func TestBuildStarIndex(t *testing.T) {
+	// Sirius is the nearest star to its own position
+	sirius, _ := starcatalog.ParseYaleBrightStarRecord(siriusBrightStarLine)
+	index, err := skyindex.BuildStarIndex([]starcatalog.Star{barnardsStar, sirius}, 8)
+	if err != nil || index.Len() != 2 {
+		t.Fatalf("Error while Building Star Index. Required: %d stars Got: %d (%v)", 2, index.Len(), err)
+	}
+	matches := index.QueryNearest(skyindex.SkyPoint{RADecimalHrs: 6.75, DecDecimalDeg: -16.7}, 1)
+	if len(matches) != 1 || matches[0].Index != 1 {
+		t.Fatalf("Error while Building Star Index. Required: %d Got: %v", 1, matches)
+	}
+}
+
+func BenchmarkBuildSpatialIndex(b *testing.B) {
+	points := generateRandomSkyPoints(100000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		skyindex.BuildSpatialIndex(points, 8)
+	}
+}
+
+func BenchmarkQueryCone(b *testing.B) {
+	points := generateRandomSkyPoints(100000)
+	index, _ := skyindex.BuildSpatialIndex(points, 8)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		index.QueryCone(points[i%len(points)], 1)
+	}
+}
+
+func BenchmarkQueryConeBruteForce(b *testing.B) {
+	// The linear scan the index replaces
+	points := generateRandomSkyPoints(100000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		centre := points[i%len(points)]
+		found := []int{}
+		for k, point := range points {
+			if calculateSkyPointDistance(centre, point) <= 1 {
+				found = append(found, k)
+			}
+		}
+	}
+}
+
+func BenchmarkQueryNearest(b *testing.B) {
+	points := generateRandomSkyPoints(100000)
+	index, _ := skyindex.BuildSpatialIndex(points, 8)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		index.QueryNearest(points[i%len(points)], 5)
+	}
+}
+
+func BenchmarkQueryBox(b *testing.B) {
+	points := generateRandomSkyPoints(100000)
+	index, _ := skyindex.BuildSpatialIndex(points, 8)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ra := float64(i%24) + 0.5
+		index.QueryBox(ra, ra+0.2, 10, 13)
+	}
+}
+
+func BenchmarkQueryPolygon(b *testing.B) {
+	points := generateRandomSkyPoints(100000)
+	index, _ := skyindex.BuildSpatialIndex(points, 8)
+	square := []skyindex.SkyPoint{{RADecimalHrs: 5, DecDecimalDeg: 20}, {RADecimalHrs: 5.1, DecDecimalDeg: 20}, {RADecimalHrs: 5.1, DecDecimalDeg: 21.5}, {RADecimalHrs: 5, DecDecimalDeg: 21.5}}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		index.QueryPolygon(square)
+	}
+}
@@ -0,0 +1,240 @@
+package tests
+
+import (
+	datetime "go-astronomy/internal/dateTime"
+	smallbody "go-astronomy/internal/smallBody"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCalculateTrueAnomalyAndRadius(t *testing.T) {
+	// Meeus chapter 35, parabolic, near-parabolic and hyperbolic orbits
+	expected := []struct {
+		perihelionDistance, eccentricity, days float64
+		trueAnomaly, radiusAU                  float64
+	}{
+		{0.921326, 1, 138.4783, 102.74426, 2.364192},
+		{0.1, 0.987, 254.9, 164.50029, 4.063777},
+		{0.123456, 0.99997, -30.47, -138.08810, 0.965053},
+		{3.363943, 1.05731, 1237.1, 109.40598, 10.668551},
+		{0.5871018, 0.9672746, 20, 52.85331, 0.729116},
+	}
+	const tolerance = 0.00001 // Define an acceptable error range
+
+	for _, e := range expected {
+		elements := smallbody.OrbitalElements{PerihelionDistance: e.perihelionDistance, Eccentricity: e.eccentricity}
+		trueAnomaly, radiusAU := smallbody.CalculateTrueAnomalyAndRadius(elements, e.days)
+		if math.Abs(trueAnomaly-e.trueAnomaly) > tolerance || math.Abs(radiusAU-e.radiusAU) > tolerance {
+			t.Fatalf("Error while Calculating True Anomaly And Radius. Required: %f %f Got: %f %f", e.trueAnomaly, e.radiusAU, trueAnomaly, radiusAU)
+		}
+	}
+
+	// Kepler's equation and the near-parabolic method must agree on either side of the limits between them
+	for _, eccentricity := range []float64{0.98, 1.02} {
+		_, radiusAU := smallbody.CalculateTrueAnomalyAndRadius(smallbody.OrbitalElements{PerihelionDistance: 0.5, Eccentricity: eccentricity - 0.000001}, 100)
+		_, nextRadiusAU := smallbody.CalculateTrueAnomalyAndRadius(smallbody.OrbitalElements{PerihelionDistance: 0.5, Eccentricity: eccentricity + 0.000001}, 100)
+		if math.Abs(radiusAU-nextRadiusAU) > tolerance {
+			t.Fatalf("Error while Calculating Radius. Required: %f Got: %f", nextRadiusAU, radiusAU)
+		}
+	}
+}
+
+func TestCalculatePositionOfSmallBody(t *testing.T) {
+	// Comet Encke on 1990 October 6.0 TD, Meeus example 33.a gives 10h 34m 13.7s +19 09' 31"
+	encke := smallbody.OrbitalElements{
+		Name:                 "2P/Encke",
+		PerihelionDistance:   2.2091404 * (1 - 0.8502196),
+		Eccentricity:         0.8502196,
+		Inclination:          11.94524,
+		AscendingNode:        334.75006,
+		ArgumentOfPerihelion: 186.23352,
+		PerihelionTime:       2448193.04502,
+	}
+	const tolerance = 0.001 // Define an acceptable error range
+
+	position := smallbody.CalculatePosition(encke, 2448170.5)
+	if math.Abs(position.RADecimalHrs-10.570472) > tolerance || math.Abs(position.DecDecimalDeg-19.158611) > tolerance {
+		t.Fatalf("Error while Calculating Position of Encke. Required: %f %f Got: %f %f", 10.570472, 19.158611, position.RADecimalHrs, position.DecDecimalDeg)
+	}
+	if math.Abs(position.GeocentricDistance-0.82427) > tolerance || math.Abs(position.Elongation-40.51) > 10*tolerance {
+		t.Fatalf("Error while Calculating Distance of Encke. Required: %f %f Got: %f %f", 0.82427, 40.51, position.GeocentricDistance, position.Elongation)
+	}
+}
+
+func TestCalculateMagnitude(t *testing.T) {
+	// At 1 AU from the Sun and the Earth and at zero phase an asteroid shines at its absolute magnitude
+	ceres := smallbody.OrbitalElements{AbsoluteMagnitude: 3.34, SlopeParameter: 0.12}
+	const tolerance = 0.01 // Define an acceptable error range
+
+	if magnitude := smallbody.CalculateMagnitude(ceres, 1, 1, 0); math.Abs(magnitude-3.34) > tolerance {
+		t.Fatalf("Error while Calculating Magnitude. Required: %f Got: %f", 3.34, magnitude)
+	}
+	comet := smallbody.OrbitalElements{AbsoluteMagnitude: 6.0, SlopeParameter: 10.0, IsComet: true}
+	if magnitude := smallbody.CalculateMagnitude(comet, 2, 1, 30); math.Abs(magnitude-9.01) > tolerance {
+		t.Fatalf("Error while Calculating Magnitude. Required: %f Got: %f", 9.01, magnitude)
+	}
+}
+
+func TestUnpackDesignation(t *testing.T) {
+	// Packed numbers and provisional designations of the Minor Planet Center
+	expected := map[string]string{
+		"00001":   "1",
+		"A0345":   "100345",
+		"~0000":   "620000",
+		"~000z":   "620061",
+		"J95X00A": "1995 XA",
+		"K07Tf8A": "2007 TA418",
+		"PLS2040": "2040 P-L",
+		"T1S3138": "3138 T-1",
+		"J95O010": "1995 O1",
+		"K19Y040": "2019 Y4",
+		"J94P01b": "1994 P1-B",
+	}
+	for packed, designation := range expected {
+		got, err := smallbody.UnpackDesignation(packed)
+		if err != nil || got != designation {
+			t.Fatalf("Error while Unpacking Designation %s. Required: %s Got: %s (%v)", packed, designation, got, err)
+		}
+	}
+	if _, err := smallbody.UnpackDesignation("J95X0"); err == nil {
+		t.Fatalf("Error while Unpacking Designation. Required: an error for an invalid designation")
+	}
+}
+
+func TestUnpackEpoch(t *testing.T) {
+	// K205V is 2020 May 31.0
+	const tolerance = 0.000001 // Define an acceptable error range
+
+	epoch, err := smallbody.UnpackEpoch("K205V")
+	if err != nil || math.Abs(epoch-2459000.5) > tolerance {
+		t.Fatalf("Error while Unpacking Epoch. Required: %f Got: %f (%v)", 2459000.5, epoch, err)
+	}
+	if _, err := smallbody.UnpackEpoch("K20DV"); err == nil {
+		t.Fatalf("Error while Unpacking Epoch. Required: an error for an invalid month")
+	}
+}
+
+const ceresMPCORBLine = "00001    3.34  0.12 K205V 162.68631   73.73161   80.28698   10.58862  0.0775571  0.21406009   2.7676569  0 MPO492748  6751 115 1801-2019 0.60 M-v 30h Williams   0000      (1) Ceres              20190915"
+const enckeCometElsLine = "0002P         2023 10 22.7415  0.339201  0.847048  187.0894  334.0197   11.3454  20230927  11.5  6.0  2P/Encke                                                 MPEC 2023-SC1"
+
+func TestParseMPCORBLine(t *testing.T) {
+	// Elements of Ceres from MPCORB.DAT
+	const tolerance = 0.000001 // Define an acceptable error range
+
+	elements, err := smallbody.ParseMPCORBLine(ceresMPCORBLine)
+	if err != nil {
+		t.Fatalf("Error while Parsing MPCORB Line: %v", err)
+	}
+	if elements.Name != "(1) Ceres" || elements.IsComet {
+		t.Fatalf("Error while Parsing MPCORB Line. Required: %s Got: %s", "(1) Ceres", elements.Name)
+	}
+	if math.Abs(elements.Epoch-2459000.5) > tolerance || math.Abs(elements.MeanAnomaly-162.68631) > tolerance || math.Abs(elements.Inclination-10.58862) > tolerance {
+		t.Fatalf("Error while Parsing MPCORB Line. Required: %f %f %f Got: %f %f %f", 2459000.5, 162.68631, 10.58862, elements.Epoch, elements.MeanAnomaly, elements.Inclination)
+	}
+	if math.Abs(elements.SemiMajorAxis()-2.7676569) > tolerance || math.Abs(elements.AbsoluteMagnitude-3.34) > tolerance || math.Abs(elements.SlopeParameter-0.12) > tolerance {
+		t.Fatalf("Error while Parsing MPCORB Line. Required: %f %f %f Got: %f %f %f", 2.7676569, 3.34, 0.12, elements.SemiMajorAxis(), elements.AbsoluteMagnitude, elements.SlopeParameter)
+	}
+}
+
+func TestParseCometElsLine(t *testing.T) {
+	// Elements of comet Encke from CometEls.txt
+	const tolerance = 0.000001 // Define an acceptable error range
+
+	elements, err := smallbody.ParseCometElsLine(enckeCometElsLine)
+	if err != nil {
+		t.Fatalf("Error while Parsing Comet Line: %v", err)
+	}
+	if elements.Name != "2P/Encke" || !elements.IsComet {
+		t.Fatalf("Error while Parsing Comet Line. Required: %s Got: %s", "2P/Encke", elements.Name)
+	}
+	if math.Abs(elements.PerihelionTime-2460240.2415) > tolerance || math.Abs(elements.PerihelionDistance-0.339201) > tolerance || math.Abs(elements.Eccentricity-0.847048) > tolerance {
+		t.Fatalf("Error while Parsing Comet Line. Required: %f %f %f Got: %f %f %f", 2460240.2415, 0.339201, 0.847048, elements.PerihelionTime, elements.PerihelionDistance, elements.Eccentricity)
+	}
+	if math.Abs(elements.AbsoluteMagnitude-11.5) > tolerance || math.Abs(elements.SlopeParameter-15.0) > tolerance {
+		t.Fatalf("Error while Parsing Comet Line. Required: %f %f Got: %f %f", 11.5, 15.0, elements.AbsoluteMagnitude, elements.SlopeParameter)
+	}
+}
+
+func TestReadMPCORBFile(t *testing.T) {
+	// The header of MPCORB.DAT ends with a row of dashes, records follow
+	path := filepath.Join(t.TempDir(), "MPCORB.DAT")
+	content := "MINOR PLANET CENTER ORBIT DATABASE (MPCORB)\n\n--------------------------------------------\n" + ceresMPCORBLine + "\n\n" + ceresMPCORBLine + "\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	elementsList, err := smallbody.ReadMPCORBFile(path)
+	if err != nil || len(elementsList) != 2 {
+		t.Fatalf("Error while Reading MPCORB File. Required: %d records Got: %d (%v)", 2, len(elementsList), err)
+	}
+
+	cometPath := filepath.Join(t.TempDir(), "CometEls.txt")
+	if err := os.WriteFile(cometPath, []byte(enckeCometElsLine+"\n"+ceresMPCORBLine[:60]+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := smallbody.ReadCometElsFile(cometPath); err == nil {
+		t.Fatalf("Error while Reading Comet File. Required: an error for a malformed line")
+	}
+}
+
+func TestCalculateOrbitFromObservations(t *testing.T) {
+	// Observations computed from known orbits, an asteroid and comet Encke, are fitted back to their elements
+	orbits := []struct {
+		elements  smallbody.OrbitalElements
+		firstDate float64
+	}{
+		{smallbody.OrbitalElements{PerihelionDistance: 2.55, Eccentricity: 0.0786, Inclination: 10.59, AscendingNode: 80.3, ArgumentOfPerihelion: 73.6, PerihelionTime: 2459200.5}, 2459100.5},
+		{smallbody.OrbitalElements{PerihelionDistance: 0.3302704, Eccentricity: 0.8502196, Inclination: 11.94524, AscendingNode: 334.75006, ArgumentOfPerihelion: 186.23352, PerihelionTime: 2448193.04502}, 2448150.5},
+	}
+	const tolerance = 0.00001 // Define an acceptable error range
+
+	for _, orbit := range orbits {
+		observations := []smallbody.Observation{}
+		for i := 0; i < 7; i++ {
+			julianDate := orbit.firstDate + float64(5*i)
+			position := smallbody.CalculatePosition(orbit.elements, datetime.ConvertUniversalTimeToEphemerisTime(julianDate))
+			observations = append(observations, smallbody.Observation{JulianDate: julianDate, RADecimalHrs: position.RADecimalHrs, DecDecimalDeg: position.DecDecimalDeg})
+		}
+
+		preliminary, err := smallbody.CalculateGaussOrbits([3]smallbody.Observation{observations[0], observations[3], observations[6]})
+		if err != nil || math.Abs(preliminary[0].Inclination-orbit.elements.Inclination) > 0.1 {
+			t.Fatalf("Error while Calculating Gauss Orbit. Required: %f Got: %+v (%v)", orbit.elements.Inclination, preliminary, err)
+		}
+
+		solution, err := smallbody.CalculateOrbitFromObservations(observations)
+		got := solution.Elements
+		if err != nil || math.Abs(got.PerihelionDistance-orbit.elements.PerihelionDistance) > tolerance || math.Abs(got.Eccentricity-orbit.elements.Eccentricity) > tolerance ||
+			math.Abs(got.Inclination-orbit.elements.Inclination) > tolerance || math.Abs(got.AscendingNode-orbit.elements.AscendingNode) > tolerance ||
+			math.Abs(got.ArgumentOfPerihelion-orbit.elements.ArgumentOfPerihelion) > tolerance || math.Abs(got.PerihelionTime-orbit.elements.PerihelionTime) > tolerance {
+			t.Fatalf("Error while Calculating Orbit From Observations. Required: %+v Got: %+v (%v)", orbit.elements, got, err)
+		}
+		if len(solution.RAResiduals) != len(observations) || solution.RMSResidual > 0.001 {
+			t.Fatalf("Error while Calculating Residuals. Required: below %f Got: %f", 0.001, solution.RMSResidual)
+		}
+	}
+
+	if _, err := smallbody.CalculateOrbitFromObservations([]smallbody.Observation{{}, {}}); err == nil {
+		t.Fatalf("Error while Calculating Orbit From Observations. Required: an error for two observations")
+	}
+}
+
+func TestConvertStateVectorToElements(t *testing.T) {
+	const tolerance = 1e-9 // Define an acceptable error range
+	const gaussianConstant = 0.01720209895
+
+	// A circular orbit in the ecliptic has neither a node nor a perihelion, and must not give NaN
+	elements := smallbody.ConvertStateVectorToElements([3]float64{0, 1, 0}, [3]float64{-gaussianConstant, 0, 0}, 2451545.0)
+	if math.IsNaN(elements.ArgumentOfPerihelion) || math.IsNaN(elements.PerihelionTime) || elements.AscendingNode != 0 || math.Abs(elements.Eccentricity) > tolerance || math.Abs(elements.Inclination) > tolerance {
+		t.Fatalf("Error while Converting State Vector To Elements. Required: a circular orbit in the ecliptic Got: %+v", elements)
+	}
+	if x, y, _ := smallbody.CalculateHeliocentricPosition(elements, 2451545.0); math.Abs(x) > 1e-6 || math.Abs(y-1) > 1e-6 {
+		t.Fatalf("Error while Converting State Vector To Elements. Required: %f %f Got: %f %f", 0.0, 1.0, x, y)
+	}
+
+	// An inclined circular orbit takes its perihelion at the ascending node
+	elements = smallbody.ConvertStateVectorToElements([3]float64{0, 2, 0}, [3]float64{0, 0, gaussianConstant / math.Sqrt(2)}, 2451545.0)
+	if math.IsNaN(elements.ArgumentOfPerihelion) || math.Abs(elements.Inclination-90) > tolerance || math.Abs(elements.AscendingNode-90) > tolerance || math.Abs(elements.PerihelionTime-2451545.0) > tolerance {
+		t.Fatalf("Error while Converting State Vector To Elements. Required: a polar circular orbit Got: %+v", elements)
+	}
+}
@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"errors"
+	starcatalog "go-astronomy/internal/starCatalog"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// Sirius in the Yale Bright Star Catalogue and in the Hipparcos main catalogue
+const siriusBrightStarLine = "2491  9Alp CMaBD-16 1591  48915151881                       064044.6-163444064508.9-164258227.22-08.89-1.46   0.00 -0.05       A1Vm                 -0.553-1.205  .375-008"
+const siriusHipparcosLine = "H|       32349| |06 45 09.25|-16 42 47.3|-1.44|1|G|101.28854105|-16.71314306| | 379.21| -546.01|-1223.08| | | | | | | | | | | | | | | | | | | | | | | | 0.009| | | | | | | | | | | | | | | | | | | | | | | | | | | | | | | | | | 48915| | | | |A0m...      |X"
+
+// Barnard's star, HIP 87937, with the radial velocity of the Hipparcos Input Catalogue
+var barnardsStar = starcatalog.Star{
+	Name:            "Barnard's Star",
+	HIP:             87937,
+	RADecimalHrs:    269.45402305 / 15,
+	DecDecimalDeg:   4.66828815,
+	Epoch:           1991.25,
+	ProperMotionRA:  -797.84,
+	ProperMotionDec: 10326.93,
+	Parallax:        549.01,
+	RadialVelocity:  -110.6,
+}
+
+func TestParseYaleBrightStarRecord(t *testing.T) {
+	const tolerance = 0.000001 // Define an acceptable error range
+
+	star, err := starcatalog.ParseYaleBrightStarRecord(siriusBrightStarLine)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raDecimalHrs, decDecimalDeg := 6+(45.0/60)+(8.9/3600), -(16 + (42.0 / 60) + (58.0 / 3600))
+	if star.HR != 2491 || star.HD != 48915 || star.Name != "9Alp CMa" || star.SpectralType != "A1Vm" {
+		t.Fatalf("Error while Parsing Bright Star Record. Required: %d %d %s %s Got: %d %d %s %s", 2491, 48915, "9Alp CMa", "A1Vm", star.HR, star.HD, star.Name, star.SpectralType)
+	}
+	if math.Abs(star.RADecimalHrs-raDecimalHrs) > tolerance || math.Abs(star.DecDecimalDeg-decDecimalDeg) > tolerance || star.Epoch != 2000.0 {
+		t.Fatalf("Error while Parsing Bright Star Record. Required: %f %f Got: %f %f", raDecimalHrs, decDecimalDeg, star.RADecimalHrs, star.DecDecimalDeg)
+	}
+	if math.Abs(star.VisualMagnitude+1.46) > tolerance || math.Abs(star.ProperMotionRA+553) > tolerance || math.Abs(star.ProperMotionDec+1205) > tolerance || math.Abs(star.Parallax-375) > tolerance || star.RadialVelocity != -8 {
+		t.Fatalf("Error while Parsing Bright Star Record. Required: %f %f %f %f %f Got: %f %f %f %f %f", -1.46, -553.0, -1205.0, 375.0, -8.0, star.VisualMagnitude, star.ProperMotionRA, star.ProperMotionDec, star.Parallax, star.RadialVelocity)
+	}
+
+	// Records kept only for the numbering have a blank position
+	if _, err := starcatalog.ParseYaleBrightStarRecord(siriusBrightStarLine[:75] + "               " + siriusBrightStarLine[90:]); !errors.Is(err, starcatalog.ErrMissingPosition) {
+		t.Fatalf("Error while Parsing Bright Star Record. Required: %v Got: %v", starcatalog.ErrMissingPosition, err)
+	}
+}
+
+func TestParseHipparcosRecord(t *testing.T) {
+	const tolerance = 0.000001 // Define an acceptable error range
+
+	star, err := starcatalog.ParseHipparcosRecord(siriusHipparcosLine)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if star.HIP != 32349 || star.HD != 48915 || star.SpectralType != "A0m..." || star.Epoch != 1991.25 {
+		t.Fatalf("Error while Parsing Hipparcos Record. Required: %d %d %s Got: %d %d %s", 32349, 48915, "A0m...", star.HIP, star.HD, star.SpectralType)
+	}
+	if math.Abs(star.RADecimalHrs*15-101.28854105) > tolerance || math.Abs(star.DecDecimalDeg+16.71314306) > tolerance {
+		t.Fatalf("Error while Parsing Hipparcos Record. Required: %f %f Got: %f %f", 101.28854105, -16.71314306, star.RADecimalHrs*15, star.DecDecimalDeg)
+	}
+	if math.Abs(star.Parallax-379.21) > tolerance || math.Abs(star.ProperMotionRA+546.01) > tolerance || math.Abs(star.ProperMotionDec+1223.08) > tolerance || math.Abs(star.ColorIndexBV-0.009) > tolerance {
+		t.Fatalf("Error while Parsing Hipparcos Record. Required: %f %f %f %f Got: %f %f %f %f", 379.21, -546.01, -1223.08, 0.009, star.Parallax, star.ProperMotionRA, star.ProperMotionDec, star.ColorIndexBV)
+	}
+}
+
+func TestReadYaleBrightStarCatalog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "catalog")
+	content := siriusBrightStarLine + "\n" + siriusBrightStarLine[:75] + "               " + siriusBrightStarLine[90:] + "\n" + siriusBrightStarLine + "\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	stars, err := starcatalog.ReadYaleBrightStarCatalog(path)
+	if err != nil || len(stars) != 2 {
+		t.Fatalf("Error while Reading Bright Star Catalogue. Required: %d stars Got: %d (%v)", 2, len(stars), err)
+	}
+
+	hipparcosPath := filepath.Join(t.TempDir(), "hip_main.dat")
+	if err := os.WriteFile(hipparcosPath, []byte(siriusHipparcosLine+"\nH|   1|\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := starcatalog.ReadHipparcosCatalog(hipparcosPath); err == nil {
+		t.Fatalf("Error while Reading Hipparcos Catalogue. Required: an error for a malformed line")
+	}
+}
+
+func TestPropagateStar(t *testing.T) {
+	// Barnard's star at J2000.0, where the linear motion gives 17h57m48.50s +4°41'36.2"
+	const tolerance = 0.1 / 3600 // Define an acceptable error range
+
+	star := starcatalog.PropagateStar(barnardsStar, 2000.0)
+	raDecimalHrs, decDecimalDeg := 17+(57.0/60)+(48.50/3600), 4+(41.0/60)+(36.2/3600)
+	if math.Abs(star.RADecimalHrs-raDecimalHrs)*15 > tolerance || math.Abs(star.DecDecimalDeg-decDecimalDeg) > tolerance || star.Epoch != 2000.0 {
+		t.Fatalf("Error while Calculating Star Propagation. Required: %f %f Got: %f %f", raDecimalHrs, decDecimalDeg, star.RADecimalHrs, star.DecDecimalDeg)
+	}
+
+	// The star approaches, so its parallax and proper motion grow, and going back recovers the catalogue
+	future := starcatalog.PropagateStar(barnardsStar, 3000.0)
+	if future.Parallax <= barnardsStar.Parallax || future.ProperMotionDec <= barnardsStar.ProperMotionDec {
+		t.Fatalf("Error while Calculating Star Propagation. Required: a growing parallax Got: %f %f", future.Parallax, future.ProperMotionDec)
+	}
+	past := starcatalog.PropagateStar(future, barnardsStar.Epoch)
+	if math.Abs(past.RADecimalHrs-barnardsStar.RADecimalHrs) > 1e-9 || math.Abs(past.DecDecimalDeg-barnardsStar.DecDecimalDeg) > 1e-9 || math.Abs(past.Parallax-barnardsStar.Parallax) > 1e-6 || math.Abs(past.RadialVelocity-barnardsStar.RadialVelocity) > 1e-6 {
+		t.Fatalf("Error while Calculating Star Propagation. Required: %f %f %f Got: %f %f %f", barnardsStar.RADecimalHrs, barnardsStar.DecDecimalDeg, barnardsStar.Parallax, past.RADecimalHrs, past.DecDecimalDeg, past.Parallax)
+	}
+
+	// Sirius from Hipparcos brought to J2000.0 agrees with the Bright Star Catalogue
+	hipparcosSirius, _ := starcatalog.ParseHipparcosRecord(siriusHipparcosLine)
+	brightStarSirius, _ := starcatalog.ParseYaleBrightStarRecord(siriusBrightStarLine)
+	sirius := starcatalog.PropagateStar(hipparcosSirius, 2000.0)
+	if math.Abs(sirius.RADecimalHrs-brightStarSirius.RADecimalHrs)*15 > 10*tolerance || math.Abs(sirius.DecDecimalDeg-brightStarSirius.DecDecimalDeg) > 10*tolerance {
+		t.Fatalf("Error while Calculating Star Propagation. Required: %f %f Got: %f %f", brightStarSirius.RADecimalHrs, brightStarSirius.DecDecimalDeg, sirius.RADecimalHrs, sirius.DecDecimalDeg)
+	}
+}
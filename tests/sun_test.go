@@ -1,6 +1,7 @@
 package tests
 
 import (
+	datetime "go-astronomy/internal/dateTime"
 	sun "go-astronomy/internal/sun"
 	"math"
 	"testing"
@@ -91,3 +92,44 @@ func TestCalculateTheEquationOfTime(t *testing.T) {
 		t.Fatalf(`Error while Calculating Suns Twilight. Required:  %d %d %f  Got: %d %d %f`, 0, 4, 30.541071, eqHrs, eqMin, eqSec)
 	}
 }
+
+func TestCalculateApparentPositionOfSun(t *testing.T) {
+	// 1992 October 13 at 0h TD
+	raHrs, raMin, raSec, decDeg, decMin, decSec, _, _, distanceAU := sun.CalculateApparentPositionOfSun(2448908.5)
+	const tolerance = 0.5 // Define an acceptable error range
+
+	if raHrs != 13 || raMin != 13 || math.Abs(raSec-31.4) > tolerance || decDeg != -7 || decMin != 47 || math.Abs(decSec-6.0) > tolerance || math.Abs(distanceAU-0.99766) > 0.00001 {
+		t.Fatalf(`Error while Calculating Apparent Position Of Sun. Required:  %d %d %f    %d %d %f   Got: %d %d %f    %d %d %f`, 13, 13, 31.4, -7, 47, 6.0, raHrs, raMin, raSec, decDeg, decMin, decSec)
+	}
+}
+
+func TestCalculateSolarDiskEphemeris(t *testing.T) {
+	// Meeus example 29.a
+	ephemeris := sun.CalculateSolarDiskEphemeris(2448908.5)
+	const tolerance = 0.01 // Define an acceptable error range
+
+	if math.Abs(ephemeris.P-26.27) > tolerance || math.Abs(ephemeris.B0-5.99) > tolerance || math.Abs(ephemeris.L0-238.63) > 2*tolerance {
+		t.Fatalf(`Error while Calculating Solar Disk Ephemeris. Required: %f %f %f Got: %f %f %f`, 26.27, 5.99, 238.63, ephemeris.P, ephemeris.B0, ephemeris.L0)
+	}
+
+	// A point taken to the disk and back must keep its heliographic coordinates
+	positionAngle, radialDistance, isOnVisibleHemisphere := sun.ConvertHeliographicToDiskPosition(ephemeris, -15.0, 260.0)
+	latitude, longitude := sun.ConvertDiskPositionToHeliographic(ephemeris, positionAngle, radialDistance)
+	if !isOnVisibleHemisphere || math.Abs(latitude+15.0) > tolerance || math.Abs(longitude-260.0) > tolerance {
+		t.Fatalf(`Error while Converting Heliographic Coordinates To Disk Position. Required: %f %f Got: %f %f`, -15.0, 260.0, latitude, longitude)
+	}
+}
+
+func TestCalculateCarringtonRotationStart(t *testing.T) {
+	// Meeus example 29.b, rotation 1699 began on 1980 August 29.22 TD, JDE 2444480.7230 given to four decimals
+	julianDate := sun.CalculateCarringtonRotationStart(1699)
+	expected := datetime.ConvertEphemerisTimeToUniversalTime(2444480.7230)
+	const tolerance = 0.00005 // Define an acceptable error range
+
+	if math.Abs(julianDate-expected) > tolerance {
+		t.Fatalf(`Error while Calculating Carrington Rotation Start. Required: %f Got: %f`, expected, julianDate)
+	}
+	if rotationNumber := sun.CalculateCarringtonRotationNumber(julianDate + 1); math.Floor(rotationNumber) != 1699 {
+		t.Fatalf(`Error while Calculating Carrington Rotation Number. Required: %d Got: %f`, 1699, rotationNumber)
+	}
+}
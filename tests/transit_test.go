@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"go-astronomy/internal/coords"
+	datetime "go-astronomy/internal/dateTime"
+	"go-astronomy/internal/transit"
+	"math"
+	"testing"
+)
+
+func TestCalculateTransits(t *testing.T) {
+	// Transits of the first part of the century with the times of greatest transit and least separation from NASA
+	expected := []struct {
+		planetName        string
+		day               float64
+		month, year       int
+		greatestTransit   float64
+		minimumSeparation float64
+	}{
+		{"Mercury", 7, 5, 2003, 7 + (52.0 / 60), 708.3},
+		{"Mercury", 8, 11, 2006, 21 + (41.0 / 60), 422.9},
+		{"Mercury", 9, 5, 2016, 14 + (57.4 / 60), 318.5},
+		{"Mercury", 11, 11, 2019, 15 + (19.8 / 60), 75.9},
+		{"Venus", 8, 6, 2004, 8 + (19.7 / 60), 626.9},
+		{"Venus", 5, 6, 2012, 25 + (29.6 / 60), 554.4},
+	}
+	const tolerance = 2.0 / 1440 // Define an acceptable error range
+
+	mercuryTransits := transit.CalculateTransits("Mercury", datetime.ConvertGreenwichDateToJulianDate(1, 1, 2000), datetime.ConvertGreenwichDateToJulianDate(1, 1, 2020))
+	venusTransits := transit.CalculateTransits("Venus", datetime.ConvertGreenwichDateToJulianDate(1, 1, 2000), datetime.ConvertGreenwichDateToJulianDate(1, 1, 2020))
+	transits := append(mercuryTransits, venusTransits...)
+	if len(transits) != len(expected) {
+		t.Fatalf(`Error while Calculating Transits for 2000-2019. Required: %d transits  Got: %d`, len(expected), len(transits))
+	}
+	for i, planetTransit := range transits {
+		greatestTransit := datetime.ConvertGreenwichDateToJulianDate(expected[i].day+(expected[i].greatestTransit/24), expected[i].month, expected[i].year)
+		if planetTransit.PlanetName != expected[i].planetName || math.Abs(planetTransit.GreatestTransit-greatestTransit) > tolerance || math.Abs(planetTransit.MinimumSeparation-expected[i].minimumSeparation) > 3 {
+			t.Fatalf(`Error while Calculating Transit of %s. Required: %f %f  Got: %f %f`, expected[i].planetName, greatestTransit, expected[i].minimumSeparation, planetTransit.GreatestTransit, planetTransit.MinimumSeparation)
+		}
+	}
+
+	// Contacts of the transit of Venus of 2012 June 5-6
+	venusTransit := venusTransits[1]
+	contacts := []float64{venusTransit.ContactI, venusTransit.ContactII, venusTransit.ContactIII, venusTransit.ContactIV}
+	expectedContacts := []float64{
+		datetime.ConvertGreenwichDateToJulianDate(5+(22+(9.6/60))/24, 6, 2012),
+		datetime.ConvertGreenwichDateToJulianDate(5+(22+(27.6/60))/24, 6, 2012),
+		datetime.ConvertGreenwichDateToJulianDate(6+(4+(31.6/60))/24, 6, 2012),
+		datetime.ConvertGreenwichDateToJulianDate(6+(4+(49.6/60))/24, 6, 2012),
+	}
+	for i := range contacts {
+		if math.Abs(contacts[i]-expectedContacts[i]) > tolerance {
+			t.Fatalf(`Error while Calculating Contacts of the Transit of Venus. Required: %f  Got: %f`, expectedContacts[i], contacts[i])
+		}
+	}
+	if math.Abs(venusTransit.PositionAngles["I"]-41) > 1 {
+		t.Fatalf(`Error while Calculating Position Angle of the Transit of Venus. Required: %f  Got: %f`, 41.0, venusTransit.PositionAngles["I"])
+	}
+}
+
+func TestCalculateLocalTransitCircumstances(t *testing.T) {
+	venusTransit := transit.CalculateTransits("Venus", datetime.ConvertGreenwichDateToJulianDate(1, 6, 2012), datetime.ConvertGreenwichDateToJulianDate(10, 6, 2012))[0]
+
+	// The end of the transit was seen from London after sunrise
+	localTransit, isVisible := transit.CalculateLocalTransitCircumstances(venusTransit, coords.Observer{GeoLatN: 51.5, GeoLong: -0.13, HeightFromSeaLevel: 10})
+	if !isVisible || localTransit.SunAltitudes["I"] > 0 || localTransit.SunAltitudes["IV"] < 0 || math.Abs(localTransit.ContactIV-venusTransit.ContactIV) > 10.0/1440 {
+		t.Fatalf(`Error while Calculating Local Transit Circumstances for London. Required: %t  Got: %t %f`, true, isVisible, localTransit.ContactIV)
+	}
+
+	// The transit took place during the night in Rio de Janeiro
+	_, isVisible = transit.CalculateLocalTransitCircumstances(venusTransit, coords.Observer{GeoLatN: -22.9, GeoLong: -43.2, HeightFromSeaLevel: 10})
+	if isVisible {
+		t.Fatalf(`Error while Calculating Local Transit Circumstances for Rio de Janeiro. Required: %t  Got: %t`, false, isVisible)
+	}
+}
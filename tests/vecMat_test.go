@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"go-astronomy/internal/coords"
+	vecmat "go-astronomy/internal/vecMat"
+	"math"
+	"testing"
+)
+
+func TestConvertSphericalDecimalDegToVec3(t *testing.T) {
+	const tolerance = 1e-9 // Define an acceptable error range
+
+	directions := [][2]float64{{0, 0}, {45, 30}, {135, -60}, {225, 10}, {315, -10}, {359.9999999, 0.5}, {120, 90}, {0, -90}}
+	for _, direction := range directions {
+		longitude, latitude := vecmat.ConvertSphericalDecimalDegToVec3(direction[0], direction[1]).Scale(7.5).ConvertToSphericalDecimalDeg()
+		if math.Abs(math.Abs(direction[1])-90) > tolerance && math.Abs(longitude-direction[0]) > tolerance || math.Abs(latitude-direction[1]) > tolerance {
+			t.Fatalf("Error while Converting Spherical Coordinates. Required: %f %f Got: %f %f", direction[0], direction[1], longitude, latitude)
+		}
+	}
+}
+
+func TestVec3(t *testing.T) {
+	const tolerance = 1e-12 // Define an acceptable error range
+
+	x, y := vecmat.Vec3{1, 0, 0}, vecmat.Vec3{0, 1, 0}
+	if x.Cross(y) != (vecmat.Vec3{0, 0, 1}) || x.Dot(y) != 0 || x.Add(y).Subtract(y) != x || math.Abs(vecmat.Vec3{3, 4, 12}.Normalize().Length()-1) > tolerance {
+		t.Fatalf("Error while Calculating Vector Products. Required: %v Got: %v", vecmat.Vec3{0, 0, 1}, x.Cross(y))
+	}
+
+	// One arcsecond apart, where the arc cosine of the dot product loses most of its digits
+	separation := vecmat.ConvertSphericalDecimalDegToVec3(10, 20).CalculateAngularSeparationDecimalDeg(vecmat.ConvertSphericalDecimalDegToVec3(10, 20+(1.0/3600)))
+	if math.Abs(separation*3600-1) > 1e-9 {
+		t.Fatalf("Error while Calculating Angular Separation. Required: %f Got: %f", 1.0, separation*3600)
+	}
+}
+
+func TestMat3(t *testing.T) {
+	const tolerance = 1e-12 // Define an acceptable error range
+
+	// A rotation of the frame by 90 degrees about z takes longitude 90 to longitude 0
+	longitude, latitude := vecmat.CalculateRotationZ(90).ApplyDecimalDeg(90, 10)
+	if math.Abs(longitude) > tolerance && math.Abs(longitude-360) > tolerance || math.Abs(latitude-10) > tolerance {
+		t.Fatalf("Error while Rotating. Required: %f %f Got: %f %f", 0.0, 10.0, longitude, latitude)
+	}
+
+	a, b := vecmat.CalculateRotationX(23.4), vecmat.CalculateRotationY(-41)
+	compareMatrices(t, "Matrix Composition", a.Then(b), b.Multiply(a), tolerance)
+	compareMatrices(t, "Matrix Transpose", a.Then(b).Then(a.Then(b).Transpose()), vecmat.Identity, tolerance)
+}
+
+func TestFrameMatrices(t *testing.T) {
+	const tolerance = 0.000001 // Define an acceptable error range
+
+	// Pollux (Meeus example 13.a)
+	lambda, beta := coords.CalculateEquatorialToEclipticMatrix(23.4392911).ApplyDecimalDeg(116.328942, 28.026183)
+	if math.Abs(lambda-113.215630) > tolerance || math.Abs(beta-6.684170) > tolerance {
+		t.Fatalf("Error while Calculating Ecliptic Matrix. Required: %f %f Got: %f %f", 113.215630, 6.684170, lambda, beta)
+	}
+
+	// Venus from Washington (Meeus example 13.b), whose azimuth of 68.0337 degrees is reckoned from the south
+	azimuth, altitude := coords.CalculateHourAngleToHorizonMatrix(38.921389).ApplyDecimalDeg(64.352133, -6.719892)
+	if math.Abs(azimuth-248.0337) > 0.0001 || math.Abs(altitude-15.1249) > 0.0001 {
+		t.Fatalf("Error while Calculating Horizon Matrix. Required: %f %f Got: %f %f", 248.0337, 15.1249, azimuth, altitude)
+	}
+
+	// The hour angle is the local sidereal time less the right ascension, and the matrix undoes itself
+	matrix := coords.CalculateEquatorialToHourAngleMatrix(128.7378734)
+	hourAngle, dec := matrix.ApplyDecimalDeg(347.3193375, -6.719892)
+	if math.Abs(hourAngle-(128.7378734-347.3193375+360)) > tolerance || math.Abs(dec-(-6.719892)) > tolerance {
+		t.Fatalf("Error while Calculating Hour Angle Matrix. Required: %f %f Got: %f %f", 128.7378734-347.3193375+360, -6.719892, hourAngle, dec)
+	}
+	compareMatrices(t, "Hour Angle Matrix", matrix.Then(matrix), vecmat.Identity, 1e-12)
+
+	// The north galactic pole and the galactic centre
+	for _, point := range [][4]float64{{192.25, 27.4, 0, 90}, {265.6108, -28.9167, 0, 0}} {
+		l, b := coords.CalculateEquatorialToGalacticMatrix().ApplyDecimalDeg(point[0], point[1])
+		if math.Abs(b-point[3]) > 0.001 || math.Abs(point[3]) < 90 && math.Min(math.Abs(l-point[2]), math.Abs(l-360-point[2])) > 0.01 {
+			t.Fatalf("Error while Calculating Galactic Matrix. Required: %f %f Got: %f %f", point[2], point[3], l, b)
+		}
+	}
+
+	// Bias, precession and nutation compose into one rotation
+	compareMatrices(t, "Precession Nutation Matrix", coords.CalculatePrecessionNutationMatrix(2460000.5), coords.CalculateNutationMatrix(2460000.5).Multiply(coords.CalculateBiasPrecessionMatrixIAU2006(2460000.5)), 1e-15)
+}